@@ -3,15 +3,34 @@ package config
 import (
 	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
+	"strings"
+	"time"
+)
+
+const (
+	// _minSecretLength is the 256 bits the HMAC-SHA256 signatures of the tokens and cursors need -.
+	_minSecretLength = 32
+	// _placeholderSecret starts the example secrets of the docs, they must never sign anything -.
+	_placeholderSecret = "change-me"
 )
 
 type (
 	// Config -.
 	Config struct {
-		App  `yaml:"app"`
-		HTTP `yaml:"http"`
-		Log  `yaml:"logger"`
-		PG   `yaml:"postgres"`
+		App         `yaml:"app"`
+		HTTP        `yaml:"http"`
+		Log         `yaml:"logger"`
+		PG          `yaml:"postgres"`
+		Paginator   `yaml:"paginator"`
+		Auth        `yaml:"auth"`
+		Scheduler   `yaml:"scheduler"`
+		Idempotency `yaml:"idempotency"`
+		Trash       `yaml:"trash"`
+		Comments    `yaml:"comments"`
+		Activity    `yaml:"activity"`
+		Lifecycle   `yaml:"lifecycle"`
+		Health      `yaml:"health"`
+		Tracing     `yaml:"tracing"`
 	}
 
 	// App -.
@@ -23,11 +42,19 @@ type (
 	// HTTP -.
 	HTTP struct {
 		Port string `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+		// TrustedProxies are the IPs or CIDR ranges of the proxies in front of the server, only their X-Forwarded-*
+		// headers are honoured. Empty trusts none -.
+		TrustedProxies []string `yaml:"trusted_proxies" env:"HTTP_TRUSTED_PROXIES"`
 	}
 
 	// Log -.
 	Log struct {
 		Level string `env-required:"true" yaml:"log_level"   env:"LOG_LEVEL"`
+		// Format is json for the log collectors or console for reading the logs locally -.
+		Format string `env-required:"true" yaml:"format" env:"LOG_FORMAT"`
+		// SampleDebug and SampleInfo keep one in every n entries of their level, 0 keeps them all -.
+		SampleDebug uint32 `yaml:"sample_debug" env:"LOG_SAMPLE_DEBUG"`
+		SampleInfo  uint32 `yaml:"sample_info"  env:"LOG_SAMPLE_INFO"`
 	}
 
 	// PG -.
@@ -37,6 +64,102 @@ type (
 		DatabaseDriver string `env-required:"true"   yaml:"database_driver"    env:"DATABASE_DRIVER"`
 		PostgresUrl    string `env-required:"true"   yaml:"PG_URL"    env:"PG_URL"`
 	}
+
+	// Paginator -.
+	Paginator struct {
+		// CursorSecret signs the cursors, it is only read from the environment -.
+		CursorSecret string `env-required:"true" yaml:"cursor_secret" env:"PAGINATOR_CURSOR_SECRET"`
+		MaxPageSize  int32  `env-required:"true" yaml:"max_page_size" env:"PAGINATOR_MAX_PAGE_SIZE"`
+	}
+
+	// Auth -.
+	Auth struct {
+		// AccessTokenSecret signs the access tokens, it is only read from the environment -.
+		AccessTokenSecret string        `env-required:"true" yaml:"access_token_secret" env:"AUTH_ACCESS_TOKEN_SECRET"`
+		AccessTokenTTL    time.Duration `env-required:"true" yaml:"access_token_ttl"    env:"AUTH_ACCESS_TOKEN_TTL"`
+		RefreshTokenTTL   time.Duration `env-required:"true" yaml:"refresh_token_ttl"   env:"AUTH_REFRESH_TOKEN_TTL"`
+		Issuer            string        `env-required:"true" yaml:"issuer"              env:"AUTH_ISSUER"`
+	}
+
+	// Scheduler -.
+	Scheduler struct {
+		Interval  time.Duration `env-required:"true" yaml:"interval"   env:"SCHEDULER_INTERVAL"`
+		BatchSize int32         `env-required:"true" yaml:"batch_size" env:"SCHEDULER_BATCH_SIZE"`
+	}
+
+	// Idempotency -.
+	Idempotency struct {
+		// KeyTTL is how long a response is replayed for its Idempotency-Key -.
+		KeyTTL        time.Duration `env-required:"true" yaml:"key_ttl"        env:"IDEMPOTENCY_KEY_TTL"`
+		PurgeInterval time.Duration `env-required:"true" yaml:"purge_interval" env:"IDEMPOTENCY_PURGE_INTERVAL"`
+		// LockLease is how long a request holds its key, a retry of a request that crashed claims the key once it runs out.
+		// Keep it above the longest request -.
+		LockLease time.Duration `env-required:"true" yaml:"lock_lease" env:"IDEMPOTENCY_LOCK_LEASE"`
+	}
+
+	// Trash -.
+	Trash struct {
+		// RetentionDays is how long a deleted blog can be restored before the purge job removes it for good -.
+		RetentionDays int           `env-required:"true" yaml:"retention_days" env:"TRASH_RETENTION_DAYS"`
+		PurgeInterval time.Duration `env-required:"true" yaml:"purge_interval" env:"TRASH_PURGE_INTERVAL"`
+	}
+
+	// Comments -.
+	Comments struct {
+		// EditWindow is how long after posting a comment its author can still edit it -.
+		EditWindow time.Duration `env-required:"true" yaml:"edit_window" env:"COMMENTS_EDIT_WINDOW"`
+		// MaxDepth is how deep the replies can nest, the top level comments are at depth 0 -.
+		MaxDepth int32 `env-required:"true" yaml:"max_depth" env:"COMMENTS_MAX_DEPTH"`
+	}
+
+	// Activity -.
+	Activity struct {
+		// FlushInterval is how often the views buffered in memory are written to the database -.
+		FlushInterval time.Duration `env-required:"true" yaml:"flush_interval" env:"ACTIVITY_FLUSH_INTERVAL"`
+		// ViewShards is how many counter rows the views of a blog are spread over per hour -.
+		ViewShards int16 `env-required:"true" yaml:"view_shards" env:"ACTIVITY_VIEW_SHARDS"`
+		// PopularHalfLife is how long it takes a view or reaction to count for half as much on the popular listing -.
+		PopularHalfLife time.Duration `env-required:"true" yaml:"popular_half_life" env:"ACTIVITY_POPULAR_HALF_LIFE"`
+		// PopularWindow is how far back the views and reactions count towards the popular listing -.
+		PopularWindow time.Duration `env-required:"true" yaml:"popular_window" env:"ACTIVITY_POPULAR_WINDOW"`
+	}
+
+	// Lifecycle -.
+	Lifecycle struct {
+		// StartupTimeout bounds the start of the app including the checks of its dependencies such as the database -.
+		StartupTimeout time.Duration `env-required:"true" yaml:"startup_timeout" env:"LIFECYCLE_STARTUP_TIMEOUT"`
+		// GracePeriod bounds the whole shutdown, keep it below the terminationGracePeriodSeconds of the pod -.
+		GracePeriod time.Duration `env-required:"true" yaml:"grace_period" env:"LIFECYCLE_GRACE_PERIOD"`
+		// DrainDelay is how long the readiness probe fails before the server stops taking requests -.
+		DrainDelay time.Duration `env-required:"true" yaml:"drain_delay" env:"LIFECYCLE_DRAIN_DELAY"`
+	}
+
+	// Health -.
+	Health struct {
+		// CacheTTL is how long a check result answers the probes before the check is run again -.
+		CacheTTL     time.Duration `env-required:"true" yaml:"cache_ttl"     env:"HEALTH_CACHE_TTL"`
+		CheckTimeout time.Duration `env-required:"true" yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+		// MigrationsDir holds the migrations the database must be migrated to before the app is started -.
+		MigrationsDir string `env-required:"true" yaml:"migrations_dir" env:"HEALTH_MIGRATIONS_DIR"`
+		// PoolSaturation is the share of the database connections in use at which the app is no longer ready -.
+		PoolSaturation float64 `env-required:"true" yaml:"pool_saturation" env:"HEALTH_POOL_SATURATION"`
+		// Downstreams maps the name of a service the app depends on to a url that answers 2xx when it is healthy -.
+		Downstreams map[string]string `yaml:"downstreams" env:"HEALTH_DOWNSTREAMS"`
+		// Verbose lets ?verbose show the check errors, they name the hosts and users of the dependencies hence keep it
+		// off unless the probes can only be reached from inside the cluster -.
+		Verbose bool `yaml:"verbose" env:"HEALTH_VERBOSE"`
+	}
+
+	// Tracing -.
+	Tracing struct {
+		// Exporter is none, stdout to print the spans for local runs or otlp to send them to a collector -.
+		Exporter    string `env-required:"true" yaml:"exporter"     env:"TRACING_EXPORTER"`
+		ServiceName string `env-required:"true" yaml:"service_name" env:"TRACING_SERVICE_NAME"`
+		// Endpoint is the OTLP/HTTP url of the collector, empty falls back to OTEL_EXPORTER_OTLP_ENDPOINT -.
+		Endpoint string `yaml:"endpoint" env:"TRACING_ENDPOINT"`
+		// SampleRatio is the share of the new traces that are recorded, the traces of the callers follow their decision -.
+		SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+	}
 )
 
 // NewConfig returns app config -.
@@ -55,9 +178,32 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if err = validateSecrets(cfg); err != nil {
+		return nil, fmt.Errorf("config error: %w", err)
+	}
+
 	return cfg, nil
 }
 
+// validateSecrets refuses the signing secrets an attacker could guess, they are only read from the environment -.
+func validateSecrets(cfg *Config) error {
+	secrets := []struct {
+		env   string
+		value string
+	}{
+		{"PAGINATOR_CURSOR_SECRET", cfg.Paginator.CursorSecret},
+		{"AUTH_ACCESS_TOKEN_SECRET", cfg.Auth.AccessTokenSecret},
+	}
+
+	for _, secret := range secrets {
+		if len(secret.value) < _minSecretLength || strings.HasPrefix(secret.value, _placeholderSecret) {
+			return fmt.Errorf("set %s to a random secret of at least %d bytes", secret.env, _minSecretLength)
+		}
+	}
+
+	return nil
+}
+
 type T struct {
 	Storage struct {
 		File struct {
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestValidateSecrets(t *testing.T) {
+	random := strings.Repeat("k", _minSecretLength)
+
+	for _, tc := range []struct {
+		name   string
+		cursor string
+		access string
+		err    string
+	}{
+		{"Random secrets", random, random, ""},
+		{"Missing secret", random, "", "set AUTH_ACCESS_TOKEN_SECRET"},
+		{"Short secret", random[1:], random, "set PAGINATOR_CURSOR_SECRET"},
+		{"Placeholder secret", random, "change-me-" + random, "set AUTH_ACCESS_TOKEN_SECRET"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &Config{Paginator: Paginator{CursorSecret: tc.cursor}, Auth: Auth{AccessTokenSecret: tc.access}}
+
+			err := validateSecrets(cfg)
+
+			if tc.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -2,73 +2,233 @@
 package app
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/harmannkibue/golang_gin_clean_architecture/config"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/pagination"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/v1"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/worker"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/auth_usecase"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/blog_usecase"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/comment_usecase"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/idempotency_usecase"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/health"
 	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/httpserver"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/lifecycle"
 	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
 	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/postgres"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/scheduler"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/tracing"
 	_ "github.com/lib/pq"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
-// Run creates objects via constructors -.
+// Run creates objects via constructors, starts them in order and stops them in reverse on a signal -.
 func Run(cfg *config.Config) {
-	l := logger.New(cfg.Log.Level)
+	l := logger.New(cfg.Log.Level,
+		logger.Format(cfg.Log.Format),
+		logger.SampleDebug(cfg.Log.SampleDebug),
+		logger.SampleInfo(cfg.Log.SampleInfo),
+	)
 
-	// HTTP Server -.
-	handler := gin.Default()
+	// The components are started in the order they are appended and stopped in reverse -.
+	lc := lifecycle.New(l,
+		lifecycle.StartTimeout(cfg.Lifecycle.StartupTimeout),
+		lifecycle.GracePeriod(cfg.Lifecycle.GracePeriod),
+		lifecycle.DrainDelay(cfg.Lifecycle.DrainDelay),
+	)
+
+	// The provider is appended first so that it is stopped last and flushes the spans of the whole shutdown -.
+	tracerProvider, err := tracing.New(
+		tracing.ServiceName(cfg.Tracing.ServiceName),
+		tracing.ServiceVersion(cfg.App.Version),
+		tracing.Exporter(cfg.Tracing.Exporter),
+		tracing.Endpoint(cfg.Tracing.Endpoint),
+		tracing.SampleRatio(cfg.Tracing.SampleRatio),
+	)
+
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - tracing.New: %w", err))
+	}
+
+	lc.Append(lifecycle.Hook{
+		Name: "tracing",
+		Stop: tracerProvider.Shutdown,
+	})
 
 	conn, err := postgres.New(cfg)
 
 	if err != nil {
-		fmt.Errorf("failed to connect to database %w", err)
+		l.Fatal(fmt.Errorf("app - Run - postgres.New: %w", err))
 	}
 
-	defer func(conn *sql.DB) {
-		err := conn.Close()
-		if err != nil {
-			panic("ERROR CLOSING POSTGRES CONNECTION")
+	// Postgres is pinged first so that an unreachable database fails the start before any traffic is taken -.
+	lc.Append(lifecycle.Hook{
+		Name:  "postgres",
+		Start: conn.PingContext,
+		Stop: func(context.Context) error {
+			return conn.Close()
+		},
+	})
+
+	// Liveness has no checks on purpose, a broken dependency is no reason to restart the app -.
+	healthRegistry := health.New(health.CacheTTL(cfg.Health.CacheTTL), health.Timeout(cfg.Health.CheckTimeout))
+
+	migrationsCheck, err := postgres.MigrationsCheck(conn, cfg.Health.MigrationsDir)
+
+	if err != nil {
+		l.Fatal(fmt.Errorf("app - Run - postgres.MigrationsCheck: %w", err))
+	}
+
+	healthRegistry.Register("lifecycle", func(context.Context) error {
+		if !lc.Ready() {
+			return errors.New("the app is starting or draining")
 		}
-	}(conn)
+
+		return nil
+	}, health.Readiness)
+	healthRegistry.Register("postgres", conn.PingContext, health.Readiness, health.Startup)
+	healthRegistry.Register("postgres_pool", postgres.PoolCheck(conn, cfg.Health.PoolSaturation), health.Readiness)
+	healthRegistry.Register("migrations", migrationsCheck, health.Startup)
+
+	// The checks pass the trace context on so that the downstream spans join the trace of the probe -.
+	downstreamClient := &http.Client{Transport: tracing.Transport(http.DefaultTransport)}
+
+	for name, url := range cfg.Health.Downstreams {
+		healthRegistry.Register(name, health.HTTPCheck(downstreamClient, url), health.Readiness)
+	}
 
 	// Initializing a store for repository -.
 	store := intfaces.NewStore(conn)
 
-	blogUsecase := blog_usecase.NewBlogUseCase(store, cfg)
+	blogUsecase := blog_usecase.NewTracedBlogUseCase(blog_usecase.NewBlogUseCase(store, cfg))
+	authUsecase := auth_usecase.NewAuthUseCase(store, cfg)
+	idempotencyUsecase := idempotency_usecase.NewIdempotencyUseCase(store, cfg)
+	commentUsecase := comment_usecase.NewCommentUseCase(store, cfg)
 
 	// Create Dependency Container -.
 	deps := intfaces.Dependencies{
-		Logger:      l,
-		BlogUsecase: blogUsecase,
+		Logger:             l,
+		BlogUsecase:        blogUsecase,
+		AuthUsecase:        authUsecase,
+		IdempotencyUsecase: idempotencyUsecase,
+		CommentUsecase:     commentUsecase,
+		Health:             healthRegistry,
+		HealthVerbose:      cfg.Health.Verbose,
+	}
+
+	// The request bindings need the custom rules before any route is served -.
+	if err = validation.Setup(cfg.Paginator.MaxPageSize); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - validation.Setup: %w", err))
+	}
+
+	// The page links only follow the forwarded host and scheme of the proxies in front of the server -.
+	if err = pagination.Setup(cfg.HTTP.TrustedProxies); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - pagination.Setup: %w", err))
+	}
+
+	// Publishes the scheduled blogs once they are due -.
+	blogPublisher := worker.NewBlogPublisher(blogUsecase, l, cfg.Scheduler.BatchSize)
+	// Deletes the idempotency keys past their TTL -.
+	idempotencyPurger := worker.NewIdempotencyPurger(idempotencyUsecase, l)
+	// Deletes the blogs trashed for longer than the retention -.
+	blogPurger := worker.NewBlogPurger(blogUsecase, l)
+	// Writes the blog views counted in memory to the view counters -.
+	blogViewFlusher := worker.NewBlogViewFlusher(blogUsecase, l)
+
+	viewHook := schedulerHook("viewScheduler", blogViewFlusher.Run, cfg.Activity.FlushInterval, l)
+	stopViewScheduler := viewHook.Stop
+	viewHook.Stop = func(ctx context.Context) error {
+		// The views counted since the last flush would be lost with the process -.
+		return errors.Join(stopViewScheduler(ctx), blogViewFlusher.Run(ctx))
+	}
+
+	lc.Append(
+		schedulerHook("publishScheduler", blogPublisher.Run, cfg.Scheduler.Interval, l),
+		schedulerHook("purgeScheduler", idempotencyPurger.Run, cfg.Idempotency.PurgeInterval, l),
+		schedulerHook("trashScheduler", blogPurger.Run, cfg.Trash.PurgeInterval, l),
+		viewHook,
+	)
+
+	// HTTP Server, v1.NewRouter adds the logging and recovery middlewares -.
+	handler := gin.New()
+
+	// Gin trusts every proxy by default, ClientIP then takes the X-Forwarded-For of anyone -.
+	if err = handler.SetTrustedProxies(cfg.HTTP.TrustedProxies); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - handler.SetTrustedProxies: %w", err))
 	}
 
 	// Passing also the basic auth middleware to all  Routers -.
 	v1.NewRouter(handler, l, deps)
 
-	httpServer := httpserver.New(handler, httpserver.Port(cfg.HTTP.Port))
+	// The server shares the grace period with the rest of the components hence its own timeout is the whole of it -.
+	httpServer := httpserver.New(handler, httpserver.Port(cfg.HTTP.Port), httpserver.ShutdownTimeout(cfg.Lifecycle.GracePeriod))
+
+	// The server is started last and stopped first so that no request finds a component already stopped -.
+	lc.Append(lifecycle.Hook{
+		Name: "httpServer",
+		Start: func(context.Context) error {
+			return httpServer.Start()
+		},
+		Stop: httpServer.Shutdown,
+	})
+
+	if err = lc.Start(context.Background()); err != nil {
+		l.Fatal(fmt.Errorf("app - Run - lc.Start: %w", err))
+	}
 
 	// Waiting signal -.
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
 
+	var serveErr error
+
 	select {
 	case s := <-interrupt:
 		l.Info("app - Run - signal: " + s.String())
-	case err = <-httpServer.Notify():
-		l.Error(fmt.Errorf("app - Run - httpServer.Notify: %w", err))
+	case serveErr = <-httpServer.Notify():
+		l.Error(fmt.Errorf("app - Run - httpServer.Notify: %w", serveErr))
 	}
 
-	// Shutdown
-	err = httpServer.Shutdown()
+	// Shutdown -.
+	if err = lc.Stop(); err != nil {
+		l.Error(fmt.Errorf("app - Run - lc.Stop: %w", err))
+	}
 
-	if err != nil {
-		l.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
+	// The process still exits non-zero when the server failed so that the failure is not mistaken for a clean stop -.
+	if serveErr != nil || err != nil {
+		l.Fatal("app - Run - stopped after a failure")
+	}
+
+	l.Info("app - Run - stopped")
+}
+
+// schedulerHook runs the job every interval from the start of the hook until its stop -.
+func schedulerHook(name string, job scheduler.Job, interval time.Duration, l logger.Interface) lifecycle.Hook {
+	var s *scheduler.Scheduler
+
+	return lifecycle.Hook{
+		Name: name,
+		Start: func(context.Context) error {
+			s = scheduler.New(job,
+				scheduler.Interval(interval),
+				scheduler.OnError(func(err error) {
+					l.Error(fmt.Errorf("app - Run - %s: %w", name, err))
+				}),
+			)
+
+			return nil
+		},
+		Stop: func(context.Context) error {
+			return s.Shutdown()
+		},
 	}
 }
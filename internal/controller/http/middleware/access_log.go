@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// AccessLog logs one entry per request once it is handled, the requests to the skipped paths such as the probes
+// are not logged -.
+func AccessLog(l logger.Interface, skipPaths ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		began := time.Now()
+
+		ctx.Next()
+
+		if slices.Contains(skipPaths, ctx.Request.URL.Path) {
+			return
+		}
+
+		// The request context holds the fields added by the middlewares after this one such as the caller -.
+		entry := logger.FromContext(ctx.Request.Context(), l).
+			With("method", ctx.Request.Method).
+			With("route", ctx.FullPath()).
+			With("path", ctx.Request.URL.Path).
+			With("status", ctx.Writer.Status()).
+			With("bytes", max(ctx.Writer.Size(), 0)).
+			With("latency_ms", float64(time.Since(began).Microseconds())/1000).
+			With("client_ip", ctx.ClientIP())
+
+		if identity, ok := entity.IdentityFromContext(ctx.Request.Context()); ok {
+			entry = entry.With(logger.UserIDKey, identity.UserID.String())
+		}
+
+		// ErrorHandler and Recovery log the failure itself at the error level -.
+		if ctx.Writer.Status() >= http.StatusInternalServerError {
+			entry.Warn("http - access")
+			return
+		}
+
+		entry.Info("http - access")
+	}
+}
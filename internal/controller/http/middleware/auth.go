@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"strings"
+)
+
+const _bearerPrefix = "Bearer "
+
+// Authenticate rejects requests without a valid bearer access token and puts the caller identity in the request context -.
+func Authenticate(auth intfaces.IntAuthUsecase) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		header := ctx.GetHeader("Authorization")
+
+		if len(header) <= len(_bearerPrefix) || !strings.EqualFold(header[:len(_bearerPrefix)], _bearerPrefix) {
+			unauthorized(ctx, entity.ErrUnauthorized.WithMessage("missing bearer access token"))
+			return
+		}
+
+		identity, err := auth.Authenticate(ctx, header[len(_bearerPrefix):])
+
+		if err != nil {
+			unauthorized(ctx, err)
+			return
+		}
+
+		reqCtx := entity.ContextWithIdentity(ctx.Request.Context(), identity)
+
+		if l := logger.FromContext(reqCtx, nil); l != nil {
+			reqCtx = logger.WithContext(reqCtx, l.With(logger.UserIDKey, identity.UserID.String()))
+		}
+
+		ctx.Request = ctx.Request.WithContext(reqCtx)
+		ctx.Next()
+	}
+}
+
+func unauthorized(ctx *gin.Context, err error) {
+	ctx.Header("WWW-Authenticate", `Bearer realm="api"`)
+	_ = ctx.Error(err)
+	ctx.Abort()
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"strings"
+)
+
+// RequireRole only lets through callers having one of the roles. It must run after Authenticate -.
+func RequireRole(roles ...entity.Role) gin.HandlerFunc {
+	names := make([]string, len(roles))
+
+	for i, role := range roles {
+		names[i] = string(role)
+	}
+
+	return func(ctx *gin.Context) {
+		identity, ok := entity.IdentityFromContext(ctx.Request.Context())
+
+		if !ok {
+			unauthorized(ctx, entity.ErrUnauthorized.WithMessage("authentication is required"))
+			return
+		}
+
+		if !identity.HasRole(roles...) {
+			_ = ctx.Error(entity.ErrForbidden.WithMessage("one of the roles " + strings.Join(names, ", ") + " is required"))
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// RequirePermission only lets through callers whose role grants the permission. It must run after Authenticate -.
+func RequirePermission(permission entity.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if _, err := entity.Authorize(ctx.Request.Context(), permission); err != nil {
+			if errors.Is(err, entity.ErrUnauthorized) {
+				unauthorized(ctx, err)
+				return
+			}
+
+			_ = ctx.Error(err)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
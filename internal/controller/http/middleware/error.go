@@ -0,0 +1,43 @@
+// Package middleware implements the gin middlewares shared by all the http api versions.
+package middleware
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"net/http"
+)
+
+// ProblemContentType is the RFC 7807 media type of the error responses -.
+const ProblemContentType = "application/problem+json"
+
+// ErrorHandler renders the last error attached by a handler with ctx.Error as a problem+json response -.
+func ErrorHandler(l logger.Interface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		if len(ctx.Errors) == 0 {
+			return
+		}
+
+		err := ctx.Errors.Last().Err
+		problem := entity.NewProblemDetails(err, ctx.Request.URL.Path)
+		// The logger of the request carries its fields such as the caller -.
+		l := logger.FromContext(ctx.Request.Context(), l)
+
+		if problem.Status >= http.StatusInternalServerError {
+			l.Error(fmt.Errorf("http - %s %s: %w", ctx.Request.Method, ctx.FullPath(), err))
+		} else {
+			l.Debug(fmt.Errorf("http - %s %s: %w", ctx.Request.Method, ctx.FullPath(), err))
+		}
+
+		// The handler has already responded, nothing more to write -.
+		if ctx.Writer.Written() {
+			return
+		}
+
+		ctx.Header("Content-Type", ProblemContentType)
+		ctx.AbortWithStatusJSON(problem.Status, problem)
+	}
+}
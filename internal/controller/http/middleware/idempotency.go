@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"io"
+	"net/http"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client generated key of a request that is safe to retry -.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on the responses replayed from a previous request -.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	// MaxIdempotentBodyBytes bounds the body read to fingerprint the request before it is validated. The largest create
+	// blog body, 100000 characters of JSON escaped content with its title, summary, description and tags, fits in it -.
+	MaxIdempotentBodyBytes = 1 << 20
+)
+
+// Idempotency makes a route safe to retry: the response to a request sent with an Idempotency-Key is saved and replayed
+// to the retries with the same key. Failed requests are not saved so that the client can retry them, requests without
+// the header run as usual -.
+func Idempotency(u intfaces.IntIdempotencyUsecase, l logger.Interface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		key := ctx.GetHeader(IdempotencyKeyHeader)
+
+		if key == "" {
+			ctx.Next()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, MaxIdempotentBodyBytes))
+
+		var tooLarge *http.MaxBytesError
+
+		if errors.As(err, &tooLarge) {
+			_ = ctx.Error(entity.ErrPayloadTooLarge.WithMessage(fmt.Sprintf("send a request body of at most %d bytes", tooLarge.Limit)).Wrap(err))
+			ctx.Abort()
+			return
+		}
+
+		if err != nil {
+			_ = ctx.Error(entity.ErrBadRequest.WithMessage("invalid request body").Wrap(err))
+			ctx.Abort()
+			return
+		}
+
+		// The handler binds the body again -.
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		saved, err := u.Begin(ctx, key, intfaces.IdempotentRequest{
+			Method: ctx.Request.Method,
+			Path:   ctx.Request.URL.Path,
+			Body:   body,
+		})
+
+		if err != nil {
+			_ = ctx.Error(err)
+			ctx.Abort()
+			return
+		}
+
+		if saved != nil {
+			ctx.Header(IdempotentReplayedHeader, "true")
+			ctx.Data(saved.Status, saved.ContentType, saved.Body)
+			ctx.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: ctx.Writer}
+		ctx.Writer = recorder
+
+		// The key is settled once the request has run, even when the client has gone away -.
+		settleCtx := context.WithoutCancel(ctx.Request.Context())
+
+		// A panic unwinds past the settling below, the key is released before passing the panic on to Recovery so that
+		// the retries are not answered with a conflict until the key expires -.
+		defer func() {
+			if r := recover(); r != nil {
+				if err := u.Release(settleCtx, key); err != nil {
+					l.Error(fmt.Errorf("http - middleware - Idempotency - Release: %w", err))
+				}
+
+				panic(r)
+			}
+		}()
+
+		ctx.Next()
+
+		if len(ctx.Errors) > 0 || recorder.Status() >= http.StatusInternalServerError {
+			err = u.Release(settleCtx, key)
+		} else {
+			err = u.Complete(settleCtx, key, intfaces.IdempotentResponse{
+				Status:      recorder.Status(),
+				ContentType: recorder.Header().Get("Content-Type"),
+				Body:        recorder.body.Bytes(),
+			})
+		}
+
+		if err != nil {
+			l.Error(fmt.Errorf("http - middleware - Idempotency: %w", err))
+		}
+	}
+}
+
+// responseRecorder keeps a copy of the response body written by the handler -.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *responseRecorder) WriteString(data string) (int, error) {
+	r.body.WriteString(data)
+
+	return r.ResponseWriter.WriteString(data)
+}
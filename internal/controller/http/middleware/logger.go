@@ -0,0 +1,15 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+)
+
+// ContextLogger binds the logger to the request context, the middlewares after it add the fields of the request
+// to it so that every entry of the request can be told apart -.
+func ContextLogger(l logger.Interface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Request = ctx.Request.WithContext(logger.WithContext(ctx.Request.Context(), l))
+		ctx.Next()
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"net/http"
+	"runtime/debug"
+)
+
+// Recovery turns a panic of a handler into the standard 500 problem+json response and logs the panic with its
+// stack. The panic unwinds past ErrorHandler hence Recovery writes the response itself -.
+func Recovery(l logger.Interface) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		defer func() {
+			recovered := recover()
+
+			if recovered == nil {
+				return
+			}
+
+			// http.ErrAbortHandler is how a handler aborts the response on purpose -.
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			logger.FromContext(ctx.Request.Context(), l).
+				With("stack", string(debug.Stack())).
+				Error(fmt.Errorf("http - %s %s: panic: %v", ctx.Request.Method, ctx.FullPath(), recovered))
+
+			if ctx.Writer.Written() {
+				ctx.Abort()
+				return
+			}
+
+			problem := entity.NewProblemDetails(entity.ErrInternalServerError, ctx.Request.URL.Path)
+
+			ctx.Header("Content-Type", ProblemContentType)
+			ctx.AbortWithStatusJSON(problem.Status, problem)
+		}()
+
+		ctx.Next()
+	}
+}
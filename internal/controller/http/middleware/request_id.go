@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"regexp"
+)
+
+// RequestIDHeader carries the id of a request between the services and back to the client -.
+const RequestIDHeader = "X-Request-ID"
+
+// _requestID bounds the ids accepted from the clients so that they can not inject into the logs -.
+var _requestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+type requestIDKey struct{}
+
+// RequestID takes the X-Request-ID of the request or generates one, echoes it in the response and adds it to the
+// request context and its logger -.
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.GetHeader(RequestIDHeader)
+
+		if !_requestID.MatchString(id) {
+			id = uuid.NewString()
+		}
+
+		ctx.Header(RequestIDHeader, id)
+
+		reqCtx := context.WithValue(ctx.Request.Context(), requestIDKey{}, id)
+
+		if l := logger.FromContext(reqCtx, nil); l != nil {
+			reqCtx = logger.WithContext(reqCtx, l.With(logger.RequestIDKey, id))
+		}
+
+		ctx.Request = ctx.Request.WithContext(reqCtx)
+		ctx.Next()
+	}
+}
+
+// RequestIDFromContext returns the id of the request, empty outside of one -.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+
+	return id
+}
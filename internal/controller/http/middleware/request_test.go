@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestMiddlewares(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(buf *bytes.Buffer) *gin.Engine {
+		l := logger.New("info", logger.Output(buf))
+
+		r := gin.New()
+		r.Use(ContextLogger(l), RequestID(), AccessLog(l, "/livez"), Recovery(l), ErrorHandler(l))
+		r.GET("/blogs/:id", func(ctx *gin.Context) {
+			logger.FromContext(ctx.Request.Context(), l).Info("handling")
+			ctx.String(http.StatusOK, RequestIDFromContext(ctx.Request.Context()))
+		})
+		r.GET("/panic", func(ctx *gin.Context) {
+			panic("nil map")
+		})
+		r.GET("/livez", func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		})
+
+		return r
+	}
+
+	t.Run("Request ID is propagated", func(t *testing.T) {
+		var buf bytes.Buffer
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/42", nil)
+		assert.NoError(t, err)
+		req.Header.Set(RequestIDHeader, "upstream-1")
+
+		newRouter(&buf).ServeHTTP(rec, req)
+
+		assert.Equal(t, "upstream-1", rec.Header().Get(RequestIDHeader))
+		assert.Equal(t, "upstream-1", rec.Body.String())
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		assert.Len(t, lines, 2)
+		assert.Contains(t, lines[0], `"request_id":"upstream-1"`)
+		assert.Contains(t, lines[1], `"route":"/blogs/:id"`)
+		assert.Contains(t, lines[1], `"status":200`)
+		assert.Contains(t, lines[1], `"bytes":10`)
+		assert.Contains(t, lines[1], `"request_id":"upstream-1"`)
+	})
+
+	t.Run("Unsafe request IDs are replaced", func(t *testing.T) {
+		var buf bytes.Buffer
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/42", nil)
+		assert.NoError(t, err)
+		req.Header.Set(RequestIDHeader, `forged" "level":"error`)
+
+		newRouter(&buf).ServeHTTP(rec, req)
+
+		_, err = uuid.Parse(rec.Header().Get(RequestIDHeader))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Panics are the standard 500", func(t *testing.T) {
+		var buf bytes.Buffer
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/panic", nil)
+		assert.NoError(t, err)
+
+		newRouter(&buf).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, ProblemContentType, rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Body.String(), `"code":"INTERNAL_SERVER_ERROR"`)
+		assert.NotContains(t, rec.Body.String(), "nil map")
+		assert.Contains(t, buf.String(), `"level":"error"`)
+		assert.Contains(t, buf.String(), "panic: nil map")
+		assert.Contains(t, buf.String(), `"stack":"goroutine`)
+		assert.Contains(t, buf.String(), `"status":500`)
+	})
+
+	t.Run("Probes are not logged", func(t *testing.T) {
+		var buf bytes.Buffer
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/livez", nil)
+		assert.NoError(t, err)
+
+		newRouter(&buf).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, buf.String())
+	})
+}
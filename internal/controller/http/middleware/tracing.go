@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"slices"
+)
+
+// Tracing continues the trace of the traceparent header, or starts one, in a server span around the request. The
+// trace id is added to the request logger so that the logs of a trace can be found. The requests to the skipped
+// paths such as the probes are not traced -.
+func Tracing(skipPaths ...string) gin.HandlerFunc {
+	tracer := tracing.Tracer()
+
+	return func(ctx *gin.Context) {
+		if slices.Contains(skipPaths, ctx.Request.URL.Path) {
+			ctx.Next()
+			return
+		}
+
+		// The route is matched before the middlewares run, the unmatched requests are named after their method only -.
+		route := ctx.FullPath()
+		name := ctx.Request.Method
+
+		if route != "" {
+			name += " " + route
+		}
+
+		reqCtx := otel.GetTextMapPropagator().Extract(ctx.Request.Context(), propagation.HeaderCarrier(ctx.Request.Header))
+		reqCtx, span := tracer.Start(reqCtx, name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(ctx.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(ctx.Request.URL.Path),
+				semconv.ClientAddress(ctx.ClientIP()),
+			),
+		)
+		defer span.End()
+
+		if l := logger.FromContext(reqCtx, nil); l != nil && span.SpanContext().IsValid() {
+			reqCtx = logger.WithContext(reqCtx, l.With(logger.TraceIDKey, span.SpanContext().TraceID().String()))
+		}
+
+		ctx.Request = ctx.Request.WithContext(reqCtx)
+		ctx.Next()
+
+		status := ctx.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+
+		// The client errors are answers rather than failures of the server -.
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+
+		for _, err := range ctx.Errors {
+			span.RecordError(err.Err)
+		}
+	}
+}
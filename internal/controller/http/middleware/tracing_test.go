@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTracing(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider, err := tracing.New(tracing.SpanExporter(exporter))
+	assert.NoError(t, err)
+
+	defer provider.Shutdown(context.Background())
+
+	newRouter := func(buf *bytes.Buffer) *gin.Engine {
+		l := logger.New("info", logger.Output(buf))
+
+		r := gin.New()
+		r.Use(ContextLogger(l), RequestID(), Tracing("/livez"), AccessLog(l), Recovery(l), ErrorHandler(l))
+		r.GET("/blogs/:id", func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		})
+		r.GET("/panic", func(ctx *gin.Context) {
+			panic("nil map")
+		})
+		r.GET("/livez", func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		})
+
+		return r
+	}
+
+	t.Run("Traceparent is continued", func(t *testing.T) {
+		exporter.Reset()
+
+		var buf bytes.Buffer
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/42", nil)
+		assert.NoError(t, err)
+		req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+		newRouter(&buf).ServeHTTP(rec, req)
+
+		spans := exporter.GetSpans()
+
+		if assert.Len(t, spans, 1) {
+			assert.Equal(t, "GET /blogs/:id", spans[0].Name)
+			assert.Equal(t, trace.SpanKindServer, spans[0].SpanKind)
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext.TraceID().String())
+			assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent.SpanID().String())
+		}
+
+		assert.Contains(t, buf.String(), `"trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"`)
+	})
+
+	t.Run("Panics fail the span", func(t *testing.T) {
+		exporter.Reset()
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/panic", nil)
+		assert.NoError(t, err)
+
+		newRouter(&bytes.Buffer{}).ServeHTTP(rec, req)
+
+		spans := exporter.GetSpans()
+
+		if assert.Len(t, spans, 1) {
+			assert.Equal(t, codes.Error, spans[0].Status.Code)
+			assert.False(t, spans[0].Parent.IsValid())
+		}
+	})
+
+	t.Run("Probes are not traced", func(t *testing.T) {
+		exporter.Reset()
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/livez", nil)
+		assert.NoError(t, err)
+
+		newRouter(&bytes.Buffer{}).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, exporter.GetSpans())
+	})
+}
@@ -0,0 +1,128 @@
+// Package pagination binds the paging query parameters and fills the page links of the listings.
+package pagination
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// trustedProxies are the proxies whose X-Forwarded-Proto and X-Forwarded-Host build the links, none until Setup -.
+var trustedProxies []*net.IPNet
+
+// Setup trusts the forwarded headers of the proxies at the IPs or CIDR ranges. Pass the same proxies to the
+// SetTrustedProxies of the gin engine so that ClientIP trusts the same ones -.
+func Setup(proxies []string) error {
+	cidrs := make([]*net.IPNet, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+
+		_, cidr, err := net.ParseCIDR(proxy)
+
+		if err != nil {
+			return fmt.Errorf("pagination - Setup - net.ParseCIDR: %w", err)
+		}
+
+		cidrs = append(cidrs, cidr)
+	}
+
+	trustedProxies = cidrs
+
+	return nil
+}
+
+// PageQuery the page numbers and sizes are kept as strings, the page and page_size rules check they are numbers in range -.
+type PageQuery struct {
+	Page         string `form:"Page" binding:"omitempty,page"`
+	ItemsPerPage string `form:"ItemsPerPage" binding:"omitempty,page_size"`
+	Count        string `form:"count" binding:"omitempty,boolean"`
+}
+
+// SkipCount ?count=false leaves out the totals to spare the COUNT query, the boolean rule has validated the value -.
+func SkipCount(count string) bool {
+	counted, err := strconv.ParseBool(count)
+
+	return err == nil && !counted
+}
+
+// SetPageLinks fills the absolute links of a page of a page paginated listing and sets them as the Link header -.
+func SetPageLinks(ctx *gin.Context, meta *intfaces.PageMeta) {
+	base := utils.RequestURL(ctx.Request, fromTrustedProxy(ctx))
+	itemsPerPage := strconv.Itoa(int(meta.ItemsPerPage))
+
+	pageURL := func(page int64) string {
+		return utils.WithQuery(base, map[string]string{"Page": strconv.FormatInt(page, 10), "ItemsPerPage": itemsPerPage})
+	}
+
+	meta.First = pageURL(1)
+
+	if meta.TotalPages != nil {
+		meta.Last = pageURL(*meta.TotalPages)
+	}
+	if meta.HasNext {
+		meta.Next = pageURL(int64(meta.CurrentPage) + 1)
+	}
+	if meta.CurrentPage > 1 {
+		meta.Previous = pageURL(int64(meta.CurrentPage) - 1)
+	}
+
+	setLinkHeader(ctx, meta)
+}
+
+// SetCursorLinks fills the links of a page of a cursor paginated listing, there is no last page to link to -.
+func SetCursorLinks(ctx *gin.Context, meta *intfaces.PageMeta, nextCursor string, prevCursor string) {
+	base := utils.RequestURL(ctx.Request, fromTrustedProxy(ctx))
+	limit := strconv.Itoa(int(meta.ItemsPerPage))
+
+	meta.First = utils.WithQuery(base, map[string]string{"cursor": "", "limit": limit})
+
+	if nextCursor != "" {
+		meta.Next = utils.WithQuery(base, map[string]string{"cursor": nextCursor, "limit": limit})
+	}
+	if prevCursor != "" {
+		meta.Previous = utils.WithQuery(base, map[string]string{"cursor": prevCursor, "limit": limit})
+	}
+
+	setLinkHeader(ctx, meta)
+}
+
+func setLinkHeader(ctx *gin.Context, meta *intfaces.PageMeta) {
+	links := utils.LinkHeader(
+		utils.PageLink{Rel: "first", URL: meta.First},
+		utils.PageLink{Rel: "prev", URL: meta.Previous},
+		utils.PageLink{Rel: "next", URL: meta.Next},
+		utils.PageLink{Rel: "last", URL: meta.Last},
+	)
+
+	if links != "" {
+		ctx.Header("Link", links)
+	}
+}
+
+// fromTrustedProxy reports whether the request was sent by one of the trusted proxies rather than straight by a client -.
+func fromTrustedProxy(ctx *gin.Context) bool {
+	ip := net.ParseIP(ctx.RemoteIP())
+
+	if ip == nil {
+		return false
+	}
+
+	for _, cidr := range trustedProxies {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
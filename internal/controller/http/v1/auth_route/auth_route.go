@@ -0,0 +1,156 @@
+package auth_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"net/http"
+)
+
+type AuthRoute struct {
+	u intfaces.IntAuthUsecase
+	l logger.Interface
+}
+
+// NewAuthRoute Initialises a new http router for the authentication -.
+func NewAuthRoute(handler *gin.RouterGroup, t intfaces.IntAuthUsecase, l logger.Interface) {
+	r := &AuthRoute{t, l}
+
+	h := handler.Group("/auth")
+	{
+		h.POST("/register", r.register)
+		h.POST("/login", r.login)
+		h.POST("/refresh", r.refresh)
+		h.POST("/logout", r.logout)
+	}
+}
+
+type registerRequestBody struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// @Summary     Register a user
+// @Description Create a new reader account, an admin grants the author role
+// @ID          Register a user
+// @Tags  	    Auth
+// @Accept      json
+// @Produce     json
+// @Param       request body registerRequestBody true "Register request body"
+// @Success     201 {object} intfaces.UserResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Router      /auth/register [post]
+func (route *AuthRoute) register(ctx *gin.Context) {
+	var body registerRequestBody
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		_ = ctx.Error(entity.ErrBadRequest.WithMessage("invalid request body").Wrap(err))
+		return
+	}
+
+	user, err := route.u.Register(ctx, intfaces.RegisterParams{
+		Email:    body.Email,
+		Password: body.Password,
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, user)
+}
+
+type loginRequestBody struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// @Summary     Login
+// @Description Exchange the user credentials for an access token and a refresh token
+// @ID          Login
+// @Tags  	    Auth
+// @Accept      json
+// @Produce     json
+// @Param       request body loginRequestBody true "Login request body"
+// @Success     200 {object} intfaces.AuthTokens
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Router      /auth/login [post]
+func (route *AuthRoute) login(ctx *gin.Context) {
+	var body loginRequestBody
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		_ = ctx.Error(entity.ErrBadRequest.WithMessage("invalid request body").Wrap(err))
+		return
+	}
+
+	tokens, err := route.u.Login(ctx, body.Email, body.Password)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tokens)
+}
+
+type refreshTokenRequestBody struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// @Summary     Refresh the access token
+// @Description Rotate the refresh token and issue a new access token, a refresh token can only be used once
+// @ID          Refresh token
+// @Tags  	    Auth
+// @Accept      json
+// @Produce     json
+// @Param       request body refreshTokenRequestBody true "Refresh token request body"
+// @Success     200 {object} intfaces.AuthTokens
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Router      /auth/refresh [post]
+func (route *AuthRoute) refresh(ctx *gin.Context) {
+	var body refreshTokenRequestBody
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		_ = ctx.Error(entity.ErrBadRequest.WithMessage("invalid request body").Wrap(err))
+		return
+	}
+
+	tokens, err := route.u.Refresh(ctx, body.RefreshToken)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, tokens)
+}
+
+// @Summary     Logout
+// @Description Revoke the refresh token of the session
+// @ID          Logout
+// @Tags  	    Auth
+// @Accept      json
+// @Param       request body refreshTokenRequestBody true "Logout request body"
+// @Success     204
+// @Failure     400 {object} entity.ProblemDetails
+// @Router      /auth/logout [post]
+func (route *AuthRoute) logout(ctx *gin.Context) {
+	var body refreshTokenRequestBody
+
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		_ = ctx.Error(entity.ErrBadRequest.WithMessage("invalid request body").Wrap(err))
+		return
+	}
+
+	if err := route.u.Logout(ctx, body.RefreshToken); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
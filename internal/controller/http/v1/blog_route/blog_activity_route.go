@@ -0,0 +1,121 @@
+package blog_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/pagination"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"net/http"
+)
+
+type reactionRequestBody struct {
+	Kind string `json:"kind" binding:"required,oneof=like love insightful funny"`
+}
+
+// @Summary     List the popular blogs
+// @Description Show the published blogs by their views and reactions of the last week, a view or reaction counts for
+// @Description half as much with every day since it was made. There are no totals as the order changes all the time
+// @ID          Popular blogs
+// @Tags  	    Blogs
+// @Produce     json
+// @Param 		Page query string false "1" "The page number that you want items for"
+// @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Success     200 {object} intfaces.ListPopularBlogsResponse
+// @Header      200 {string} Link "RFC 8288 links to the first, prev and next pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/popular [get]
+func (route *BlogRoute) popularBlogs(ctx *gin.Context) {
+	query := pagination.PageQuery{Page: "1", ItemsPerPage: "10"}
+
+	if err := validation.Bind(ctx, validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blogs, err := route.u.ListPopularBlogs(ctx, intfaces.ListPopularBlogsParams{
+		Page:  query.Page,
+		Limit: query.ItemsPerPage,
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	pagination.SetPageLinks(ctx, &blogs.PageMeta)
+
+	ctx.JSON(http.StatusOK, blogs)
+}
+
+// @Summary     React to a blog
+// @Description Set the reaction of the caller on a published blog, reacting again replaces the earlier reaction
+// @ID          React to a blog
+// @Tags  	    Blogs
+// @Accept      json
+// @Produce     json
+// @Param       id      path string              true "blog ID"
+// @Param       request body reactionRequestBody true "Reaction request body"
+// @Success     200 {object} intfaces.BlogStats
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/reaction [put]
+func (route *BlogRoute) reactToBlog(ctx *gin.Context) {
+	var uri idURI
+	var body reactionRequestBody
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.JSON(&body)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	stats, err := route.u.ReactToBlog(ctx, uri.ID, body.Kind)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, stats)
+}
+
+// @Summary     Remove the reaction to a blog
+// @Description Take back the reaction of the caller on a blog
+// @ID          Remove a blog reaction
+// @Tags  	    Blogs
+// @Produce     json
+// @Param       id path string true "blog ID"
+// @Success     200 {object} intfaces.BlogStats
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/reaction [delete]
+func (route *BlogRoute) removeBlogReaction(ctx *gin.Context) {
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	stats, err := route.u.RemoveBlogReaction(ctx, uri.ID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, stats)
+}
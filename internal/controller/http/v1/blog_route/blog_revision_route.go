@@ -0,0 +1,166 @@
+package blog_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/pagination"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"net/http"
+)
+
+type revisionURI struct {
+	ID  string `uri:"id" binding:"required,uuid"`
+	Rev string `uri:"rev" binding:"required,revision"`
+}
+
+type diffRevisionsQuery struct {
+	From string `form:"from" binding:"required,revision"`
+	To   string `form:"to" binding:"required,revision"`
+}
+
+// @Summary     List the revisions of a blog
+// @Description Show the history of the content of a blog, latest first. Only the author of the blog can see it
+// @ID          Blog revisions
+// @Tags  	    Blogs
+// @Produce     json
+// @Param       id path string true "blog ID"
+// @Param 		Page query string false "1" "The page number that you want items for"
+// @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Param 		count query bool false "Set to false to leave out total_items, total_pages and the last link" default(true)
+// @Success     200 {object} intfaces.ListBlogRevisionsResponse
+// @Header      200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/revisions [get]
+func (route *BlogRoute) blogRevisions(ctx *gin.Context) {
+	var uri idURI
+	query := pagination.PageQuery{Page: "1", ItemsPerPage: "10"}
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	revisions, err := route.u.ListBlogRevisions(ctx, uri.ID, intfaces.ListBlogRevisionsParams{
+		Page:      query.Page,
+		Limit:     query.ItemsPerPage,
+		SkipCount: pagination.SkipCount(query.Count),
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	pagination.SetPageLinks(ctx, &revisions.PageMeta)
+
+	ctx.JSON(http.StatusOK, revisions)
+}
+
+// @Summary     Fetch a revision of a blog
+// @Description Show the content of a blog at a revision
+// @ID          Blog revision
+// @Tags  	    Blogs
+// @Produce     json
+// @Param       id  path string  true "blog ID"
+// @Param       rev path integer true "revision number"
+// @Success     200 {object} sqlc.BlogRevision
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/revisions/{rev} [get]
+func (route *BlogRoute) blogRevision(ctx *gin.Context) {
+	var uri revisionURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	revision, err := route.u.GetBlogRevision(ctx, uri.ID, uri.Rev)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revision)
+}
+
+// @Summary     Diff two revisions of a blog
+// @Description Line level diff of the title, summary, descriptions and body from one revision to another, only the changed fields are listed
+// @ID          Diff blog revisions
+// @Tags  	    Blogs
+// @Produce     json
+// @Param       id   path  string  true "blog ID"
+// @Param       from query integer true "revision to diff from"
+// @Param       to   query integer true "revision to diff to"
+// @Success     200 {object} intfaces.BlogRevisionDiff
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/revisions/diff [get]
+func (route *BlogRoute) diffBlogRevisions(ctx *gin.Context) {
+	var uri idURI
+	var query diffRevisionsQuery
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	diff, err := route.u.DiffBlogRevisions(ctx, uri.ID, query.From, query.To)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, diff)
+}
+
+// @Summary     Restore a revision of a blog
+// @Description Bring back the content of a revision, the restore is recorded as a new revision
+// @ID          Restore a blog revision
+// @Tags  	    Blogs
+// @Produce     json
+// @Param       id  path string  true "blog ID"
+// @Param       rev path integer true "revision number"
+// @Param       If-Match header string true "ETag of the blog being changed"
+// @Success     200 {object} singleBlogResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Failure     412 {object} entity.ProblemDetails
+// @Failure     428 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/revisions/{rev}/restore [post]
+func (route *BlogRoute) restoreBlogRevision(ctx *gin.Context) {
+	var uri revisionURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blog, err := route.u.RestoreBlogRevision(ctx, uri.ID, uri.Rev, ifMatch(ctx))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderBlog(ctx, blog)
+}
@@ -2,20 +2,40 @@ package blog_route
 
 import (
 	"database/sql"
+	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/middleware"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/pagination"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/mocks"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
 	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 )
 
+func TestMain(m *testing.M) {
+	if err := validation.Setup(validation.DefaultMaxPageSize); err != nil {
+		panic(err)
+	}
+
+	if err := pagination.Setup([]string{"10.0.0.0/8"}); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
 //https://dev.to/jacobsngoodwin/04-testing-first-gin-http-handler-9m0 -.
 func TestGetByID(t *testing.T) {
 	// Setup -.
@@ -35,7 +55,8 @@ func TestGetByID(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(rec)
 
-		mockBlogUsecase.On("GetBlog", c, mockBlog.ID.String()).Return(&mockBlog, nil)
+		mockBlogUsecase.On("ViewBlog", c, mockBlog.ID.String()).
+			Return(&intfaces.BlogView{Blog: mockBlog, Stats: intfaces.BlogStats{Views: 7, Reactions: map[sqlc.ReactionKind]int64{sqlc.ReactionKindLike: 2}}}, nil)
 
 		req, err := http.NewRequestWithContext(c, http.MethodGet, "/blogs/"+mockBlog.ID.String(), strings.NewReader(""))
 		assert.NoError(t, err)
@@ -51,21 +72,58 @@ func TestGetByID(t *testing.T) {
 		handler.blog(c)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"stats":{"views":7,"reactions":{"like":2}}`)
 		mockBlogUsecase.AssertExpectations(t)
 	})
 
 	t.Run("Blog not found", func(t *testing.T) {
 		mockBlogUsecase := new(mocks.BlogUsecase)
 
+		rec := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(rec)
+
+		id := uuid.NewString()
+
+		mockBlogUsecase.On("ViewBlog", mock.Anything, id).Return(nil, entity.ErrNotFound.WithMessage("Blog not found"))
+
+		req, err := http.NewRequest(http.MethodGet, "/blogs/"+id, strings.NewReader(""))
+		assert.NoError(t, err)
+
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/:id", handler.blog)
+		r.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, middleware.ProblemContentType, rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Body.String(), `"code":"NOT_FOUND"`)
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+}
+
+func TestDeleteBlog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Success", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
 		rec := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(rec)
 
 		id := uuid.NewString()
 
-		mockBlogUsecase.On("GetBlog", c, id).Return(nil, entity.CreateError(entity.ErrNotFound.Error(), "Blog not found"))
+		etag := `"` + id + `-1"`
+
+		mockBlogUsecase.On("DeleteBlog", c, id, entity.Precondition{ETags: []string{etag}, Sent: true}).Return(nil)
 
-		req, err := http.NewRequestWithContext(c, http.MethodGet, "/blogs/"+id, strings.NewReader(""))
+		req, err := http.NewRequestWithContext(c, http.MethodDelete, "/blogs/"+id, strings.NewReader(""))
 		assert.NoError(t, err)
+		req.Header.Set("If-Match", etag)
 
 		c.Request = req
 		c.Params = append(c.Params, gin.Param{Key: "id", Value: id})
@@ -75,10 +133,674 @@ func TestGetByID(t *testing.T) {
 			l: logger.New("info"),
 		}
 
-		handler.blog(c)
+		handler.deleteBlog(c)
+		c.Writer.WriteHeaderNow()
 
-		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Invalid blog id", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(rec)
+
+		req, err := http.NewRequest(http.MethodDelete, "/blogs/not-a-uuid", strings.NewReader(""))
+		assert.NoError(t, err)
+
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.DELETE("/blogs/:id", handler.deleteBlog)
+		r.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), `{"field":"id","in":"path","code":"invalid_uuid","message":"must be a UUID"}`)
+		mockBlogUsecase.AssertNotCalled(t, "DeleteBlog", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Missing If-Match", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(rec)
+
+		id := uuid.NewString()
+
+		mockBlogUsecase.On("DeleteBlog", mock.Anything, id, entity.Precondition{}).
+			Return(entity.ErrPreconditionMissing.WithMessage("send the ETag of the resource in the If-Match header"))
+
+		req, err := http.NewRequest(http.MethodDelete, "/blogs/"+id, strings.NewReader(""))
+		assert.NoError(t, err)
+
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.DELETE("/blogs/:id", handler.deleteBlog)
+		r.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"PRECONDITION_REQUIRED"`)
+		mockBlogUsecase.AssertExpectations(t)
+	})
+}
+
+func TestBlogETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	blog := sqlc.Blog{ID: uuid.New(), Title: "Clean architecture", Version: 3}
+	etag := entity.ETag(blog.ID, blog.Version)
+
+	newRouter := func(mockBlogUsecase *mocks.BlogUsecase) *gin.Engine {
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r := gin.New()
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/:id", handler.blog)
+		r.PATCH("/blogs/:id", handler.patchBlog)
+
+		return r
+	}
+
+	t.Run("Read sets the ETag", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		mockBlogUsecase.On("ViewBlog", mock.Anything, blog.ID.String()).Return(&intfaces.BlogView{Blog: blog}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/"+blog.ID.String(), nil)
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, etag, rec.Header().Get("ETag"))
+	})
+
+	t.Run("Unchanged read is not modified", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		mockBlogUsecase.On("ViewBlog", mock.Anything, blog.ID.String()).Return(&intfaces.BlogView{Blog: blog}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/"+blog.ID.String(), nil)
+		assert.NoError(t, err)
+		req.Header.Set("If-None-Match", `"stale", W/`+etag)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Equal(t, etag, rec.Header().Get("ETag"))
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("Stale change fails", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		title := "Renamed"
+		mockBlogUsecase.On("PatchBlog", mock.Anything, blog.ID.String(), intfaces.PatchBlogParams{Title: &title}, entity.Precondition{ETags: []string{`"stale"`}, Sent: true}).
+			Return(nil, entity.ErrPreconditionFailed.WithDetails(map[string]interface{}{"etag": etag}))
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPatch, "/blogs/"+blog.ID.String(), strings.NewReader(`{"title":"Renamed"}`))
+		assert.NoError(t, err)
+		req.Header.Set("If-Match", `"stale"`)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Change returns the new ETag", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		title := "Renamed"
+		changed := blog
+		changed.Title, changed.Version = title, blog.Version+1
+		mockBlogUsecase.On("PatchBlog", mock.Anything, blog.ID.String(), intfaces.PatchBlogParams{Title: &title}, entity.Precondition{ETags: []string{etag}, Sent: true}).
+			Return(&changed, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPatch, "/blogs/"+blog.ID.String(), strings.NewReader(`{"title":"Renamed"}`))
+		assert.NoError(t, err)
+		req.Header.Set("If-Match", etag)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, entity.ETag(blog.ID, changed.Version), rec.Header().Get("ETag"))
+	})
+}
+
+func TestListBlogs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Query language", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(rec)
+
+		blog := sqlc.Blog{ID: uuid.New(), Title: "Clean architecture", Body: "Not selected"}
+
+		mockBlogUsecase.On("ListBlogs", mock.Anything, intfaces.ListBlogsParams{
+			Page:      "1",
+			Limit:     "10",
+			Sort:      "-created_at,title",
+			Filters:   map[string]string{"status": "published", "user_role": "author"},
+			Fields:    "id,title",
+			SkipCount: true,
+		}).Return(&intfaces.ListBlogsResponse{
+			Blog:     []sqlc.Blog{blog},
+			PageMeta: intfaces.PageMeta{CurrentPage: 1, ItemsPerPage: 10},
+			Fields:   []string{"id", "title"},
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, "/blogs/?sort=-created_at,title&filter[status]=published&user_role=author&fields=id,title&count=false", nil)
+		assert.NoError(t, err)
+		req.Host = "localhost"
+
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/", handler.blogs)
+		r.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"blogs":[{"id":"`+blog.ID.String()+`","title":"Clean architecture"}],"next_page":"","previous_page":"",`+
+			`"current_page":1,"items_per_page":10,`+
+			`"first":"http://localhost/blogs/?ItemsPerPage=10&Page=1&count=false&fields=id%2Ctitle&filter%5Bstatus%5D=published&sort=-created_at%2Ctitle&user_role=author"}`, rec.Body.String())
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Pagination links", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(rec)
+
+		totalItems, totalPages := int64(25), int64(3)
+
+		mockBlogUsecase.On("ListBlogs", mock.Anything, intfaces.ListBlogsParams{
+			Page:    "2",
+			Limit:   "10",
+			Filters: map[string]string{},
+		}).Return(&intfaces.ListBlogsResponse{
+			Blog: []sqlc.Blog{},
+			PageMeta: intfaces.PageMeta{
+				CurrentPage:  2,
+				ItemsPerPage: 10,
+				TotalItems:   &totalItems,
+				TotalPages:   &totalPages,
+				HasNext:      true,
+			},
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, "/blogs/?Page=2&ItemsPerPage=10", nil)
+		assert.NoError(t, err)
+		req.Host = "internal:8080"
+		req.RemoteAddr = "10.0.0.2:43210"
+		req.Header.Set("X-Forwarded-Proto", "https")
+		req.Header.Set("X-Forwarded-Host", "api.example.com")
+
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/", handler.blogs)
+		r.ServeHTTP(rec, req)
+
+		page := func(n int) string {
+			return "https://api.example.com/blogs/?ItemsPerPage=10&Page=" + strconv.Itoa(n)
+		}
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"blogs":[],"next_page":"","previous_page":"","current_page":2,"items_per_page":10,"total_items":25,"total_pages":3,`+
+			`"first":"`+page(1)+`","previous":"`+page(1)+`","next":"`+page(3)+`","last":"`+page(3)+`"}`, rec.Body.String())
+		assert.Equal(t, `<`+page(1)+`>; rel="first", <`+page(1)+`>; rel="prev", <`+page(3)+`>; rel="next", <`+page(3)+`>; rel="last"`, rec.Header().Get("Link"))
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Forwarded headers of clients are ignored", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(rec)
+
+		mockBlogUsecase.On("ListBlogs", mock.Anything, mock.Anything).Return(&intfaces.ListBlogsResponse{
+			Blog:     []sqlc.Blog{},
+			PageMeta: intfaces.PageMeta{CurrentPage: 1, ItemsPerPage: 10},
+		}, nil)
+
+		req, err := http.NewRequest(http.MethodGet, "/blogs/", nil)
+		assert.NoError(t, err)
+		req.Host = "api.example.com"
+		req.RemoteAddr = "203.0.113.7:43210"
+		req.Header.Set("X-Forwarded-Proto", "https")
+		req.Header.Set("X-Forwarded-Host", "evil.example.com")
+
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/", handler.blogs)
+		r.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `<http://api.example.com/blogs/?ItemsPerPage=10&Page=1>; rel="first"`, rec.Header().Get("Link"))
+	})
+
+	t.Run("Invalid count", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(rec)
+
+		req, err := http.NewRequest(http.MethodGet, "/blogs/?count=maybe", nil)
+		assert.NoError(t, err)
+
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/", handler.blogs)
+		r.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		mockBlogUsecase.AssertNotCalled(t, "ListBlogs", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRequestValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for name, tc := range map[string]struct {
+		method string
+		target string
+		body   string
+		errors string
+	}{
+		"every invalid body field": {
+			method: http.MethodPost,
+			target: "/blogs/create-blog/",
+			body:   `{"title":"   ","summary":"` + strings.Repeat("a", 501) + `"}`,
+			errors: `[{"field":"title","in":"body","code":"blank","message":"must not be blank"},` +
+				`{"field":"summary","in":"body","code":"too_long","message":"must be at most 500 characters long"}]`,
+		},
+		"too many tags": {
+			method: http.MethodPost,
+			target: "/blogs/create-blog/",
+			body:   `{"title":"Tagged","tags":["a","b","c","d","e","f","g","h","i","j","k"]}`,
+			errors: `[{"field":"tags","in":"body","code":"too_many","message":"must have at most 10 items"}]`,
+		},
+		"wrong body type": {
+			method: http.MethodPatch,
+			target: "/blogs/" + uuid.NewString(),
+			body:   `{"title":7}`,
+			errors: `[{"field":"title","in":"body","code":"invalid_type","message":"must be a string"}]`,
+		},
+		"path and body together": {
+			method: http.MethodPut,
+			target: "/blogs/42",
+			body:   `{}`,
+			errors: `[{"field":"id","in":"path","code":"invalid_uuid","message":"must be a UUID"},` +
+				`{"field":"title","in":"body","code":"required","message":"is required"}]`,
+		},
+		"page out of range": {
+			method: http.MethodGet,
+			target: "/blogs/?Page=abc&ItemsPerPage=1000&author=me",
+			errors: `[{"field":"Page","in":"query","code":"invalid_page","message":"must be a whole number from 1 to 21474836"},` +
+				`{"field":"ItemsPerPage","in":"query","code":"invalid_page_size","message":"must be a whole number from 1 to 100"},` +
+				`{"field":"author","in":"query","code":"invalid_uuid","message":"must be a UUID"}]`,
+		},
+		"page past the largest offset": {
+			method: http.MethodGet,
+			target: "/blogs/?Page=2147483647&ItemsPerPage=50",
+			errors: `[{"field":"Page","in":"query","code":"invalid_page","message":"must be a whole number from 1 to 21474836"}]`,
+		},
+		"negative cursor limit": {
+			method: http.MethodGet,
+			target: "/blogs/?limit=-1",
+			errors: `[{"field":"limit","in":"query","code":"invalid_page_size","message":"must be a whole number from 1 to 100"}]`,
+		},
+		"missing search words": {
+			method: http.MethodGet,
+			target: "/blogs/search?count=maybe",
+			errors: `[{"field":"count","in":"query","code":"invalid_boolean","message":"must be true or false"},` +
+				`{"field":"q","in":"query","code":"required","message":"is required"}]`,
+		},
+		"malformed slug": {
+			method: http.MethodGet,
+			target: "/blogs/slug/Not_A-Slug",
+			errors: `[{"field":"slug","in":"path","code":"invalid_slug","message":"must only have lowercase letters, digits and single hyphens"}]`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockBlogUsecase := new(mocks.BlogUsecase)
+
+			rec := httptest.NewRecorder()
+			_, r := gin.CreateTestContext(rec)
+
+			req, err := http.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			assert.NoError(t, err)
+
+			handler := BlogRoute{
+				u: mockBlogUsecase,
+				l: logger.New("info"),
+			}
+
+			r.Use(middleware.ErrorHandler(handler.l))
+			r.POST("/blogs/create-blog/", handler.createBlog)
+			r.GET("/blogs/", handler.blogs)
+			r.GET("/blogs/search", handler.searchBlogs)
+			r.GET("/blogs/slug/:slug", handler.blogBySlug)
+			r.PUT("/blogs/:id", handler.updateBlog)
+			r.PATCH("/blogs/:id", handler.patchBlog)
+			r.ServeHTTP(rec, req)
+
+			var problem entity.ProblemDetails
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			errors, err := json.Marshal(problem.Details["errors"])
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+			assert.Equal(t, "VALIDATION_FAILED", problem.Code)
+			assert.JSONEq(t, tc.errors, string(errors))
+			mockBlogUsecase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateBlogIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockBlogUsecase := new(mocks.BlogUsecase)
+	mockIdempotencyUsecase := new(mocks.IdempotencyUsecase)
+
+	blog := sqlc.Blog{ID: uuid.New(), Title: "Clean architecture", Slug: "clean-architecture"}
+	body := `{"title":"Clean architecture"}`
+	request := intfaces.IdempotentRequest{Method: http.MethodPost, Path: "/blogs/create-blog/", Body: []byte(body)}
+
+	handler := BlogRoute{
+		u: mockBlogUsecase,
+		l: logger.New("info"),
+	}
+
+	r := gin.New()
+	r.Use(middleware.Recovery(handler.l), middleware.ErrorHandler(handler.l))
+	r.POST("/blogs/create-blog/", middleware.Idempotency(mockIdempotencyUsecase, handler.l), handler.createBlog)
+
+	send := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+
+		req, err := http.NewRequest(http.MethodPost, "/blogs/create-blog/", strings.NewReader(body))
+		assert.NoError(t, err)
+		req.Header.Set(middleware.IdempotencyKeyHeader, "key-1")
+
+		r.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	// The first request runs and its response is saved -.
+	mockIdempotencyUsecase.On("Begin", mock.Anything, "key-1", request).Return(nil, nil).Once()
+	mockBlogUsecase.On("CreateBlog", mock.Anything, intfaces.CreateBlogParams{Title: "Clean architecture"}).Return(&blog, nil).Once()
+
+	var saved intfaces.IdempotentResponse
+
+	mockIdempotencyUsecase.On("Complete", mock.Anything, "key-1", mock.AnythingOfType("intfaces.IdempotentResponse")).
+		Run(func(args mock.Arguments) { saved = args.Get(2).(intfaces.IdempotentResponse) }).
+		Return(nil).Once()
+
+	first := send()
+
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Equal(t, http.StatusCreated, saved.Status)
+	assert.Equal(t, first.Body.String(), string(saved.Body))
+
+	// The retry replays it without creating another blog -.
+	mockIdempotencyUsecase.On("Begin", mock.Anything, "key-1", request).Return(&saved, nil).Once()
+
+	retry := send()
+
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get(middleware.IdempotentReplayedHeader))
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+
+	// Failed requests are released so the client can fix and retry them -.
+	mockIdempotencyUsecase.On("Begin", mock.Anything, "key-1", request).Return(nil, nil).Once()
+	mockBlogUsecase.On("CreateBlog", mock.Anything, mock.Anything).Return(nil, entity.ErrInternalServerError).Once()
+	mockIdempotencyUsecase.On("Release", mock.Anything, "key-1").Return(nil).Once()
+
+	assert.Equal(t, http.StatusInternalServerError, send().Code)
+
+	// The body is bounded before it is read into memory -.
+	rec := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodPost, "/blogs/create-blog/", strings.NewReader(`{"body":"`+strings.Repeat("a", middleware.MaxIdempotentBodyBytes)+`"}`))
+	assert.NoError(t, err)
+	req.Header.Set(middleware.IdempotencyKeyHeader, "key-2")
+
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	mockIdempotencyUsecase.AssertNotCalled(t, "Begin", mock.Anything, "key-2", mock.Anything)
+
+	// So are the requests that panicked -.
+	mockIdempotencyUsecase.On("Begin", mock.Anything, "key-1", request).Return(nil, nil).Once()
+	mockBlogUsecase.On("CreateBlog", mock.Anything, mock.Anything).Run(func(mock.Arguments) { panic("nil map") }).Once()
+	mockIdempotencyUsecase.On("Release", mock.Anything, "key-1").Return(nil).Once()
+
+	assert.Equal(t, http.StatusInternalServerError, send().Code)
+
+	mockBlogUsecase.AssertExpectations(t)
+	mockIdempotencyUsecase.AssertExpectations(t)
+}
+
+func TestBlogRevisions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	id := uuid.NewString()
+
+	newRouter := func(mockBlogUsecase *mocks.BlogUsecase) *gin.Engine {
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r := gin.New()
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/:id/revisions/diff", handler.diffBlogRevisions)
+		r.GET("/blogs/:id/revisions/:rev", handler.blogRevision)
+
+		return r
+	}
+
+	t.Run("Diff", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		mockBlogUsecase.On("DiffBlogRevisions", mock.Anything, id, "1", "3").
+			Return(&intfaces.BlogRevisionDiff{From: 1, To: 3, Changes: []intfaces.BlogFieldDiff{}}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/"+id+"/revisions/diff?from=1&to=3", nil)
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"from":1,"to":3,"changes":[]}`, rec.Body.String())
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Invalid revisions", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/"+id+"/revisions/diff?from=0", nil)
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), `{"field":"from","in":"query","code":"invalid_revision","message":"must be a whole number of 1 or more"}`)
+		assert.Contains(t, rec.Body.String(), `{"field":"to","in":"query","code":"required","message":"is required"}`)
+
+		rec = httptest.NewRecorder()
+		req, err = http.NewRequest(http.MethodGet, "/blogs/"+id+"/revisions/latest", nil)
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"invalid_revision"`)
+		mockBlogUsecase.AssertNotCalled(t, "GetBlogRevision", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestBlogTags(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(mockBlogUsecase *mocks.BlogUsecase) *gin.Engine {
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r := gin.New()
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/", handler.blogs)
+		r.GET("/tags/", handler.tags)
+
+		return r
+	}
+
+	t.Run("Filter by repeated tags", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		mockBlogUsecase.On("ListBlogs", mock.Anything, mock.MatchedBy(func(args intfaces.ListBlogsParams) bool {
+			return assert.ObjectsAreEqual([]string{"go", "postgres"}, args.Tags) && args.TagMatch == "all"
+		})).Return(&intfaces.ListBlogsResponse{Blog: []sqlc.Blog{}}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/?tag=go&tag=postgres&tag_match=all", nil)
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Unknown tag match", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/?tag=go&tag_match=some", nil)
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), `{"field":"tag_match","in":"query","code":"invalid_choice","message":"must be one of any, all"}`)
+		mockBlogUsecase.AssertNotCalled(t, "ListBlogs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Tags with usage counts", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		mockBlogUsecase.On("ListTags", mock.Anything, intfaces.ListTagsParams{Page: "1", Limit: "10"}).
+			Return(&intfaces.ListTagsResponse{Tags: []sqlc.ListTagsRow{{Name: "Go", Slug: "go", UsageCount: 3}}}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/tags/", nil)
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"slug":"go"`)
+		assert.Contains(t, rec.Body.String(), `"usageCount":3`)
 		mockBlogUsecase.AssertExpectations(t)
 	})
+}
+
+func TestBlogReactions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
 
+	blogID := uuid.NewString()
+
+	newRouter := func(mockBlogUsecase *mocks.BlogUsecase) *gin.Engine {
+		handler := BlogRoute{
+			u: mockBlogUsecase,
+			l: logger.New("info"),
+		}
+
+		r := gin.New()
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.GET("/blogs/popular", handler.popularBlogs)
+		r.GET("/blogs/:id", handler.blog)
+		r.PUT("/blogs/:id/reaction", handler.reactToBlog)
+
+		return r
+	}
+
+	t.Run("React", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		mockBlogUsecase.On("ReactToBlog", mock.Anything, blogID, "insightful").
+			Return(&intfaces.BlogStats{Views: 12, Reactions: map[sqlc.ReactionKind]int64{sqlc.ReactionKindInsightful: 1}, MyReaction: sqlc.ReactionKindInsightful}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPut, "/blogs/"+blogID+"/reaction", strings.NewReader(`{"kind":"insightful"}`))
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"views":12,"reactions":{"insightful":1},"my_reaction":"insightful"}`, rec.Body.String())
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Unknown reaction", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPut, "/blogs/"+blogID+"/reaction", strings.NewReader(`{"kind":"angry"}`))
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"invalid_choice","message":"must be one of like, love, insightful, funny"`)
+		mockBlogUsecase.AssertNotCalled(t, "ReactToBlog", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Popular is not a blog ID", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		mockBlogUsecase.On("ListPopularBlogs", mock.Anything, intfaces.ListPopularBlogsParams{Page: "1", Limit: "10"}).
+			Return(&intfaces.ListPopularBlogsResponse{Blogs: []sqlc.ListPopularBlogsRow{{Title: "Trending", Score: 4.5}}}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/blogs/popular", nil)
+		assert.NoError(t, err)
+
+		newRouter(mockBlogUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"score":4.5`)
+		mockBlogUsecase.AssertExpectations(t)
+	})
 }
@@ -0,0 +1,86 @@
+package blog_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/pagination"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	db "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"net/http"
+)
+
+type blogTagsResponse struct {
+	Tags []db.Tag `json:"tags"`
+}
+
+// @Summary     List the tags
+// @Description Show the tags with the number of published blogs using them, most used first
+// @ID          Fetch Tags
+// @Tags  	    Tags
+// @Produce     json
+// @Param 		Page query string false "1" "The page number that you want items for"
+// @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Param 		count query bool false "Set to false to leave out total_items, total_pages and the last link" default(true)
+// @Success     200 {object} intfaces.ListTagsResponse
+// @Header      200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /tags/ [get]
+func (route *BlogRoute) tags(ctx *gin.Context) {
+	query := pagination.PageQuery{Page: "1", ItemsPerPage: "10"}
+
+	if err := validation.Bind(ctx, validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	tags, err := route.u.ListTags(ctx, intfaces.ListTagsParams{
+		Page:      query.Page,
+		Limit:     query.ItemsPerPage,
+		SkipCount: pagination.SkipCount(query.Count),
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	pagination.SetPageLinks(ctx, &tags.PageMeta)
+
+	ctx.JSON(http.StatusOK, tags)
+}
+
+// @Summary     List the tags of a blog
+// @Description Show the tags of a blog, blogs that are not published are only shown to their author
+// @ID          Blog tags
+// @Tags  	    Tags
+// @Produce     json
+// @Param       id path string true "blog ID"
+// @Success     200 {object} blogTagsResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/tags [get]
+func (route *BlogRoute) blogTags(ctx *gin.Context) {
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	tags, err := route.u.ListBlogTags(ctx, uri.ID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, blogTagsResponse{Tags: tags})
+}
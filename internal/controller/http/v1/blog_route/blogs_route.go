@@ -2,12 +2,15 @@ package blog_route
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/middleware"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/pagination"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
 	db "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
 	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
-	_ "github.com/swaggo/swag/example/celler/httputil"
 	"net/http"
+	"time"
 )
 
 type BlogRoute struct {
@@ -15,16 +18,72 @@ type BlogRoute struct {
 	l logger.Interface
 }
 
-// NewBlogRoute Initialises a new http router for the blogs -.
-func NewBlogRoute(handler *gin.RouterGroup, t intfaces.IntBlogUsecase, l logger.Interface) {
+// idURI is the ID path parameter of the blog and user routes -.
+type idURI struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+type slugURI struct {
+	Slug string `uri:"slug" binding:"required,slug"`
+}
+
+type listBlogsQuery struct {
+	pagination.PageQuery
+	Cursor string `form:"cursor"`
+	Limit  string `form:"limit" binding:"omitempty,page_size"`
+	Author string `form:"author" binding:"omitempty,uuid"`
+	// Tags are repeated as ?tag=go&tag=postgres -.
+	Tags     []string `form:"tag" binding:"omitempty,max=10,dive,notblank,max=50"`
+	TagMatch string   `form:"tag_match" binding:"omitempty,oneof=any all"`
+}
+
+type trashedBlogsQuery struct {
+	pagination.PageQuery
+	Author string `form:"author" binding:"omitempty,uuid"`
+}
+
+type searchBlogsQuery struct {
+	pagination.PageQuery
+	Q string `form:"q" binding:"required,notblank,max=256"`
+}
+
+// NewBlogRoute Initialises a new http router for the blogs, creating a blog honours the Idempotency-Key header -.
+func NewBlogRoute(handler *gin.RouterGroup, t intfaces.IntBlogUsecase, i intfaces.IntIdempotencyUsecase, l logger.Interface) {
 	r := &BlogRoute{t, l}
 
 	h := handler.Group("/blogs")
 	{
-		h.POST("/create-blog/", r.createBlog)
-		h.GET("/", r.blogs)
-		h.GET("/:id", r.blog)
+		h.POST("/create-blog/", middleware.RequirePermission(entity.PermissionBlogCreate), middleware.Idempotency(i, l), r.createBlog)
+		h.GET("/", middleware.RequirePermission(entity.PermissionBlogRead), r.blogs)
+		h.GET("/search", middleware.RequirePermission(entity.PermissionBlogRead), r.searchBlogs)
+		h.GET("/trash", middleware.RequirePermission(entity.PermissionBlogTrash), r.trashedBlogs)
+		h.GET("/popular", middleware.RequirePermission(entity.PermissionBlogRead), r.popularBlogs)
+		h.GET("/:id", middleware.RequirePermission(entity.PermissionBlogRead), r.blog)
+		h.GET("/slug/:slug", middleware.RequirePermission(entity.PermissionBlogRead), r.blogBySlug)
+		h.PUT("/:id", middleware.RequirePermission(entity.PermissionBlogUpdate), r.updateBlog)
+		h.PATCH("/:id", middleware.RequirePermission(entity.PermissionBlogUpdate), r.patchBlog)
+		h.DELETE("/:id", middleware.RequirePermission(entity.PermissionBlogDelete), r.deleteBlog)
+		h.POST("/:id/publish", middleware.RequirePermission(entity.PermissionBlogPublish), r.publishBlog)
+		h.POST("/:id/unpublish", middleware.RequirePermission(entity.PermissionBlogPublish), r.unpublishBlog)
+		h.POST("/:id/archive", middleware.RequirePermission(entity.PermissionBlogPublish), r.archiveBlog)
+		h.POST("/:id/restore", middleware.RequirePermission(entity.PermissionBlogTrash), r.restoreBlog)
+		h.GET("/:id/revisions", middleware.RequirePermission(entity.PermissionBlogUpdate), r.blogRevisions)
+		h.GET("/:id/revisions/diff", middleware.RequirePermission(entity.PermissionBlogUpdate), r.diffBlogRevisions)
+		h.GET("/:id/revisions/:rev", middleware.RequirePermission(entity.PermissionBlogUpdate), r.blogRevision)
+		h.POST("/:id/revisions/:rev/restore", middleware.RequirePermission(entity.PermissionBlogUpdate), r.restoreBlogRevision)
+		h.GET("/:id/tags", middleware.RequirePermission(entity.PermissionBlogRead), r.blogTags)
+		h.PUT("/:id/reaction", middleware.RequirePermission(entity.PermissionBlogReact), r.reactToBlog)
+		h.DELETE("/:id/reaction", middleware.RequirePermission(entity.PermissionBlogReact), r.removeBlogReaction)
+	}
+
+	g := handler.Group("/tags")
+	{
+		g.GET("/", middleware.RequirePermission(entity.PermissionBlogRead), r.tags)
+	}
 
+	u := handler.Group("/users")
+	{
+		u.GET("/:id/blogs", middleware.RequirePermission(entity.PermissionBlogRead), r.userBlogs)
 	}
 }
 
@@ -33,30 +92,78 @@ type singleBlogResponse struct {
 }
 
 // @Summary     Fetch single blog by ID
-// @Description Show a single blog registered
+// @Description Show a single blog registered with its views and reactions, every fetch of a published blog counts as a view.
+// @Description The ETag only covers the blog, the stats of a 304 are those the client already has
 // @ID          Single blog
 // @Tags  	    Blogs
 // @Accept      json
 // @Produce     json
 // @Param        id   path      string  true  "blog ID"
-// @Success     200 {object} singleBlogResponse
-// @Failure     400 {object} httputil.HTTPError
+// @Param       If-None-Match header string false "ETag of the blog the client has"
+// @Success     200 {object} intfaces.BlogView
+// @Success     304
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
 // @Router      /blogs/{id} [get]
 func (route *BlogRoute) blog(ctx *gin.Context) {
-	id := ctx.Param("id")
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	view, err := route.u.ViewBlog(ctx, uri.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderBlogView(ctx, view)
+}
+
+// @Summary     Fetch single blog by slug
+// @Description Show a single blog by its slug, blogs that are not published are only shown to their author
+// @ID          Single blog by slug
+// @Tags  	    Blogs
+// @Accept      json
+// @Produce     json
+// @Param       slug path      string  true  "blog slug"
+// @Param       If-None-Match header string false "ETag of the blog the client has"
+// @Success     200 {object} singleBlogResponse
+// @Success     304
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/slug/{slug} [get]
+func (route *BlogRoute) blogBySlug(ctx *gin.Context) {
+	var uri slugURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
 
-	blog, err := route.u.GetBlog(ctx, id)
+	blog, err := route.u.GetBlogBySlug(ctx, uri.Slug)
 	if err != nil {
-		route.l.Error(err, "http - v1 - getting single blog")
-		ctx.JSON(entity.GetStatusCode(err), entity.ErrorCodeResponse(err))
+		_ = ctx.Error(err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, blog)
+	renderBlog(ctx, blog)
 }
 
 type createBlogRequestBody struct {
-	Description string `json:"description"`
+	Title       string   `json:"title" binding:"required,notblank,max=255"`
+	Body        string   `json:"body" binding:"max=100000"`
+	Summary     string   `json:"summary" binding:"max=500"`
+	Description string   `json:"description" binding:"max=1000"`
+	Tags        []string `json:"tags" binding:"omitempty,max=10,dive,notblank,max=50"`
 }
 
 type createBlogResponse struct {
@@ -66,32 +173,47 @@ type createBlogResponse struct {
 
 type listBlogsResponse struct {
 	Blogs []createBlogResponse `json:"blogs"`
+	intfaces.PageMeta
 }
 
 // @Summary     Create a blog
-// @Description Create a blog
+// @Description Create a blog. Retries sent with the same Idempotency-Key replay the first response instead of creating another blog.
 // @ID          Create a blog
 // @Tags  	    Blogs
 // @Accept      json
 // @Produce     json
+// @Param       Idempotency-Key header string false "Client generated key, up to 255 characters, that makes the request safe to retry"
 // @Param       request body createBlogRequestBody true "Create blog request body"
 // @Success     201 {object} createBlogResponse
-// @Failure     400 {object} httputil.HTTPError
-// @Failure     500 {object} httputil.HTTPError
+// @Header      201 {string} Idempotent-Replayed "true when the response was replayed for a repeated Idempotency-Key"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Failure     413 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     500 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
 // @Router      /blogs/create-blog/ [post]
 func (route *BlogRoute) createBlog(ctx *gin.Context) {
 	var body createBlogRequestBody
 
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		route.l.Error(err, "http - v1 - create a blog route")
-		ctx.JSON(entity.GetStatusCode(err), entity.ErrorCodeResponse(err))
+	if err := validation.Bind(ctx, validation.JSON(&body)); err != nil {
+		_ = ctx.Error(err)
 		return
 	}
 
-	blog, err := route.u.CreateBlog(ctx, body.Description)
+	blog, err := route.u.CreateBlog(ctx, intfaces.CreateBlogParams{
+		Title:       body.Title,
+		Body:        body.Body,
+		Summary:     body.Summary,
+		Description: body.Description,
+		Tags:        body.Tags,
+	})
 
 	if err != nil {
-		ctx.JSON(entity.GetStatusCode(err), entity.ErrorCodeResponse(err))
+		_ = ctx.Error(err)
+		return
 	}
 
 	ctx.JSON(http.StatusCreated, blog)
@@ -105,28 +227,507 @@ func (route *BlogRoute) createBlog(ctx *gin.Context) {
 // @Produce     json
 // @Param 		Page query string false "1" "The page number that you want items for"
 // @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Param 		cursor query string false "Opaque cursor from next_cursor or prev_cursor, switches to cursor pagination"
+// @Param 		limit query string false "10" "The number of items per page in cursor pagination"
+// @Param 		author query string false "Only list the blogs of this author ID"
+// @Param 		sort query string false "Comma separated fields to sort by, a leading - sorts in descending order e.g -created_at,title. One of created_at, updated_at, published_at and title"
+// @Param 		filter[status] query string false "Only list the blogs in this status"
+// @Param 		user_role query string false "Only list the blogs with this user role"
+// @Param 		created_after query string false "Only list the blogs created at or after this RFC 3339 time or date"
+// @Param 		created_before query string false "Only list the blogs created before this RFC 3339 time or date"
+// @Param 		tag query []string false "Only list the blogs with these tags, repeat it for more tags" collectionFormat(multi)
+// @Param 		tag_match query string false "Whether the blogs need any or all of the tags" Enums(any, all) default(any)
+// @Param 		fields query string false "Comma separated fields to return e.g id,title"
+// @Param 		count query bool false "Set to false to leave out total_items, total_pages and the last link, counting is slow on huge tables" default(true)
 // @Success     200 {object} listBlogsResponse
-// @Failure     400 {object} httputil.HTTPError
+// @Header      200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
 // @Router      /blogs/ [get]
 func (route *BlogRoute) blogs(ctx *gin.Context) {
+	var query listBlogsQuery
+
+	if err := validation.Bind(ctx, validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	route.listBlogs(ctx, query, query.Author)
+}
 
-	page := ctx.Request.URL.Query().Get("Page")
-	limit := ctx.Request.URL.Query().Get("ItemsPerPage")
+// @Summary     List the Blogs of a user
+// @Description Show the blogs authored by a user
+// @ID          Fetch user Blogs
+// @Tags  	    Blogs
+// @Accept      json
+// @Produce     json
+// @Param       id   path      string  true  "user ID"
+// @Param 		Page query string false "1" "The page number that you want items for"
+// @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Param 		cursor query string false "Opaque cursor from next_cursor or prev_cursor, switches to cursor pagination"
+// @Param 		limit query string false "10" "The number of items per page in cursor pagination"
+// @Param 		sort query string false "Comma separated fields to sort by, a leading - sorts in descending order e.g -created_at,title. One of created_at, updated_at, published_at and title"
+// @Param 		filter[status] query string false "Only list the blogs in this status"
+// @Param 		user_role query string false "Only list the blogs with this user role"
+// @Param 		created_after query string false "Only list the blogs created at or after this RFC 3339 time or date"
+// @Param 		created_before query string false "Only list the blogs created before this RFC 3339 time or date"
+// @Param 		tag query []string false "Only list the blogs with these tags, repeat it for more tags" collectionFormat(multi)
+// @Param 		tag_match query string false "Whether the blogs need any or all of the tags" Enums(any, all) default(any)
+// @Param 		fields query string false "Comma separated fields to return e.g id,title"
+// @Param 		count query bool false "Set to false to leave out total_items, total_pages and the last link, counting is slow on huge tables" default(true)
+// @Success     200 {object} listBlogsResponse
+// @Header      200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /users/{id}/blogs [get]
+func (route *BlogRoute) userBlogs(ctx *gin.Context) {
+	var uri idURI
+	var query listBlogsQuery
 
-	if page == "" {
-		page = "1"
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	route.listBlogs(ctx, query, uri.ID)
+}
+
+func (route *BlogRoute) listBlogs(ctx *gin.Context, bound listBlogsQuery, authorID string) {
+	query := ctx.Request.URL.Query()
+	params := intfaces.ListBlogsParams{
+		AuthorID:  authorID,
+		Sort:      query.Get("sort"),
+		Filters:   ctx.QueryMap("filter"),
+		Fields:    query.Get("fields"),
+		Tags:      bound.Tags,
+		TagMatch:  bound.TagMatch,
+		SkipCount: pagination.SkipCount(bound.Count),
 	}
-	if limit == "" {
-		limit = "10"
+
+	// The most used filters can also be passed as plain query parameters -.
+	for _, filter := range []string{"user_role", "created_after", "created_before"} {
+		if query.Has(filter) {
+			params.Filters[filter] = query.Get(filter)
+		}
 	}
 
-	blogs, err := route.u.ListBlogs(ctx, intfaces.ListBlogsParams{
-		Page:  page,
-		Limit: limit,
+	// Cursor pagination is used when either of its query parameters is passed -.
+	if query.Has("cursor") || query.Has("limit") {
+		params.UseCursor = true
+		params.Cursor = bound.Cursor
+		params.Limit = bound.Limit
+	} else {
+		params.Page = bound.Page
+		params.Limit = bound.ItemsPerPage
+	}
+
+	if params.Page == "" {
+		params.Page = "1"
+	}
+	if params.Limit == "" {
+		params.Limit = "10"
+	}
+
+	blogs, err := route.u.ListBlogs(ctx, params)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	if params.UseCursor {
+		pagination.SetCursorLinks(ctx, &blogs.PageMeta, blogs.NextCursor, blogs.PrevCursor)
+	} else {
+		pagination.SetPageLinks(ctx, &blogs.PageMeta)
+	}
+
+	ctx.JSON(http.StatusOK, blogs)
+}
+
+// @Summary     Search the Blogs
+// @Description Full text search over the title, summary and body of the blogs, best matches first with the matches highlighted in the headline.
+// @Description Quoted words must follow each other, word* matches words starting with word and -word excludes blogs containing it.
+// @ID          Search Blogs
+// @Tags  	    Blogs
+// @Accept      json
+// @Produce     json
+// @Param 		q query string true "The words to search for"
+// @Param 		Page query string false "1" "The page number that you want items for"
+// @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Param 		count query bool false "Set to false to leave out total_items, total_pages and the last link, counting is slow on huge tables" default(true)
+// @Success     200 {object} intfaces.SearchBlogsResponse
+// @Header      200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/search [get]
+func (route *BlogRoute) searchBlogs(ctx *gin.Context) {
+	query := searchBlogsQuery{PageQuery: pagination.PageQuery{Page: "1", ItemsPerPage: "10"}}
+
+	if err := validation.Bind(ctx, validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blogs, err := route.u.SearchBlogs(ctx, intfaces.SearchBlogsParams{
+		Query:     query.Q,
+		Page:      query.Page,
+		Limit:     query.ItemsPerPage,
+		SkipCount: pagination.SkipCount(query.Count),
 	})
 
 	if err != nil {
-		ctx.JSON(entity.GetStatusCode(err), entity.ErrorCodeResponse(err))
+		_ = ctx.Error(err)
+		return
 	}
+
+	pagination.SetPageLinks(ctx, &blogs.PageMeta)
+
 	ctx.JSON(http.StatusOK, blogs)
 }
+
+type updateBlogRequestBody struct {
+	Title       string `json:"title" binding:"required,notblank,max=255"`
+	Body        string `json:"body" binding:"max=100000"`
+	Summary     string `json:"summary" binding:"max=500"`
+	Description string `json:"description" binding:"max=1000"`
+	// Tags are kept when left out and removed by an empty list -.
+	Tags []string `json:"tags" binding:"omitempty,max=10,dive,notblank,max=50"`
+}
+
+// @Summary     Update a blog
+// @Description Replace all the editable fields of a blog
+// @ID          Update a blog
+// @Tags  	    Blogs
+// @Accept      json
+// @Produce     json
+// @Param       id      path string                true "blog ID"
+// @Param       request body updateBlogRequestBody true "Update blog request body"
+// @Param       If-Match header string true "ETag of the blog being changed"
+// @Success     200 {object} singleBlogResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     500 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Failure     412 {object} entity.ProblemDetails
+// @Failure     428 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id} [put]
+func (route *BlogRoute) updateBlog(ctx *gin.Context) {
+	var uri idURI
+	var body updateBlogRequestBody
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.JSON(&body)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blog, err := route.u.UpdateBlog(ctx, uri.ID, intfaces.UpdateBlogParams{
+		Title:       body.Title,
+		Body:        body.Body,
+		Summary:     body.Summary,
+		Description: body.Description,
+		Tags:        body.Tags,
+	}, ifMatch(ctx))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderBlog(ctx, blog)
+}
+
+type patchBlogRequestBody struct {
+	Title       *string  `json:"title" binding:"omitempty,notblank,max=255"`
+	Body        *string  `json:"body" binding:"omitempty,max=100000"`
+	Summary     *string  `json:"summary" binding:"omitempty,max=500"`
+	Description *string  `json:"description" binding:"omitempty,max=1000"`
+	Tags        []string `json:"tags" binding:"omitempty,max=10,dive,notblank,max=50"`
+}
+
+// @Summary     Partially update a blog
+// @Description Update only the fields passed in the request body
+// @ID          Patch a blog
+// @Tags  	    Blogs
+// @Accept      json
+// @Produce     json
+// @Param       id      path string               true "blog ID"
+// @Param       request body patchBlogRequestBody true "Patch blog request body"
+// @Param       If-Match header string true "ETag of the blog being changed"
+// @Success     200 {object} singleBlogResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     500 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Failure     412 {object} entity.ProblemDetails
+// @Failure     428 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id} [patch]
+func (route *BlogRoute) patchBlog(ctx *gin.Context) {
+	var uri idURI
+	var body patchBlogRequestBody
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.JSON(&body)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blog, err := route.u.PatchBlog(ctx, uri.ID, intfaces.PatchBlogParams{
+		Title:       body.Title,
+		Body:        body.Body,
+		Summary:     body.Summary,
+		Description: body.Description,
+		Tags:        body.Tags,
+	}, ifMatch(ctx))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderBlog(ctx, blog)
+}
+
+// @Summary     Delete a blog
+// @Description Move a single blog to the trash, it can be restored by an admin until it is purged
+// @ID          Delete a blog
+// @Tags  	    Blogs
+// @Param       id path string true "blog ID"
+// @Param       If-Match header string true "ETag of the blog being changed"
+// @Success     204
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     500 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Failure     412 {object} entity.ProblemDetails
+// @Failure     428 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id} [delete]
+func (route *BlogRoute) deleteBlog(ctx *gin.Context) {
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	if err := route.u.DeleteBlog(ctx, uri.ID, ifMatch(ctx)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+type publishBlogRequestBody struct {
+	// PublishAt schedules the blog when it is in the future, it is published right away otherwise -.
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+// @Summary     Publish a blog
+// @Description Publish a blog now, or schedule it by passing a publish_at in the future
+// @ID          Publish a blog
+// @Tags  	    Blogs
+// @Accept      json
+// @Produce     json
+// @Param       id      path string                 true  "blog ID"
+// @Param       request body publishBlogRequestBody false "Publish blog request body"
+// @Param       If-Match header string true "ETag of the blog being changed"
+// @Success     200 {object} singleBlogResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Failure     412 {object} entity.ProblemDetails
+// @Failure     428 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/publish [post]
+func (route *BlogRoute) publishBlog(ctx *gin.Context) {
+	var uri idURI
+	var body publishBlogRequestBody
+
+	bindings := []validation.Binding{validation.URI(&uri)}
+
+	// The body is optional, publishing right away needs none -.
+	if ctx.Request.ContentLength != 0 {
+		bindings = append(bindings, validation.JSON(&body))
+	}
+
+	if err := validation.Bind(ctx, bindings...); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blog, err := route.u.PublishBlog(ctx, uri.ID, body.PublishAt, ifMatch(ctx))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderBlog(ctx, blog)
+}
+
+// @Summary     Unpublish a blog
+// @Description Move a scheduled, published or archived blog back to draft
+// @ID          Unpublish a blog
+// @Tags  	    Blogs
+// @Produce     json
+// @Param       id path string true "blog ID"
+// @Param       If-Match header string true "ETag of the blog being changed"
+// @Success     200 {object} singleBlogResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Failure     412 {object} entity.ProblemDetails
+// @Failure     428 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/unpublish [post]
+func (route *BlogRoute) unpublishBlog(ctx *gin.Context) {
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blog, err := route.u.UnpublishBlog(ctx, uri.ID, ifMatch(ctx))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderBlog(ctx, blog)
+}
+
+// @Summary     Archive a blog
+// @Description Archive a blog so that it is no longer listed
+// @ID          Archive a blog
+// @Tags  	    Blogs
+// @Produce     json
+// @Param       id path string true "blog ID"
+// @Param       If-Match header string true "ETag of the blog being changed"
+// @Success     200 {object} singleBlogResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Failure     412 {object} entity.ProblemDetails
+// @Failure     428 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/archive [post]
+func (route *BlogRoute) archiveBlog(ctx *gin.Context) {
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blog, err := route.u.ArchiveBlog(ctx, uri.ID, ifMatch(ctx))
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderBlog(ctx, blog)
+}
+
+// @Summary     List the trashed Blogs
+// @Description Show the deleted blogs of every author that can still be restored, latest deleted first
+// @ID          Trashed Blogs
+// @Tags  	    Blogs
+// @Accept      json
+// @Produce     json
+// @Param 		Page query string false "1" "The page number that you want items for"
+// @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Param 		author query string false "Only list the trashed blogs of this author"
+// @Param 		count query bool false "Set to false to leave out total_items, total_pages and the last link, counting is slow on huge tables" default(true)
+// @Success     200 {object} listBlogsResponse
+// @Header      200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/trash [get]
+func (route *BlogRoute) trashedBlogs(ctx *gin.Context) {
+	query := trashedBlogsQuery{PageQuery: pagination.PageQuery{Page: "1", ItemsPerPage: "10"}}
+
+	if err := validation.Bind(ctx, validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blogs, err := route.u.ListTrashedBlogs(ctx, intfaces.ListTrashedBlogsParams{
+		Page:      query.Page,
+		Limit:     query.ItemsPerPage,
+		AuthorID:  query.Author,
+		SkipCount: pagination.SkipCount(query.Count),
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	pagination.SetPageLinks(ctx, &blogs.PageMeta)
+
+	ctx.JSON(http.StatusOK, blogs)
+}
+
+// @Summary     Restore a blog
+// @Description Take a deleted blog out of the trash as it was when it was deleted
+// @ID          Restore a blog
+// @Tags  	    Blogs
+// @Produce     json
+// @Param       id path string true "blog ID"
+// @Success     200 {object} singleBlogResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/restore [post]
+func (route *BlogRoute) restoreBlog(ctx *gin.Context) {
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	blog, err := route.u.RestoreBlog(ctx, uri.ID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	renderBlog(ctx, blog)
+}
@@ -0,0 +1,47 @@
+package blog_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	db "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"net/http"
+)
+
+// ifMatch is the version of the blog the client changes, the usecase refuses the change when it is missing or stale -.
+func ifMatch(ctx *gin.Context) entity.Precondition {
+	return entity.ParsePrecondition(ctx.GetHeader("If-Match"), false)
+}
+
+// renderBlog responds with the blog tagged with its ETag, a read whose If-None-Match still matches gets a 304 -.
+func renderBlog(ctx *gin.Context, blog *db.Blog) {
+	if notModified(ctx, blog) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, blog)
+}
+
+// renderBlogView responds with the blog and its stats tagged with the ETag of the blog. The stats are not part of
+// the ETag as it guards the content of the blog, a client revalidating its copy keeps the stats it has -.
+func renderBlogView(ctx *gin.Context, view *intfaces.BlogView) {
+	if notModified(ctx, &view.Blog) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, view)
+}
+
+// notModified sets the ETag of the blog and answers a read with a 304 when its If-None-Match still matches -.
+func notModified(ctx *gin.Context, blog *db.Blog) bool {
+	etag := entity.ETag(blog.ID, blog.Version)
+
+	ctx.Header("ETag", etag)
+
+	if ctx.Request.Method == http.MethodGet && entity.ParsePrecondition(ctx.GetHeader("If-None-Match"), true).Matches(etag) {
+		ctx.Status(http.StatusNotModified)
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,275 @@
+package comment_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/middleware"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/pagination"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"net/http"
+)
+
+type CommentRoute struct {
+	u intfaces.IntCommentUsecase
+	l logger.Interface
+}
+
+// idURI is the ID path parameter of the blog and comment routes -.
+type idURI struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// NewCommentRoute Initialises a new http router for the comments of the blogs -.
+func NewCommentRoute(handler *gin.RouterGroup, t intfaces.IntCommentUsecase, l logger.Interface) {
+	r := &CommentRoute{t, l}
+
+	b := handler.Group("/blogs")
+	{
+		b.POST("/:id/comments", middleware.RequirePermission(entity.PermissionCommentCreate), r.createComment)
+		b.GET("/:id/comments", middleware.RequirePermission(entity.PermissionCommentRead), r.blogComments)
+	}
+
+	h := handler.Group("/comments")
+	{
+		h.GET("/:id", middleware.RequirePermission(entity.PermissionCommentRead), r.comment)
+		h.GET("/:id/thread", middleware.RequirePermission(entity.PermissionCommentRead), r.commentThread)
+		h.PATCH("/:id", middleware.RequirePermission(entity.PermissionCommentUpdate), r.editComment)
+		// Moderators delete any comment hence the usecase decides between the delete and moderate permissions -.
+		h.DELETE("/:id", r.deleteComment)
+	}
+}
+
+type createCommentRequestBody struct {
+	Body string `json:"body" binding:"required,notblank,max=10000"`
+	// ParentID replies to a comment of the same blog, leave it out to post a top level comment -.
+	ParentID string `json:"parent_id" binding:"omitempty,uuid"`
+}
+
+type editCommentRequestBody struct {
+	Body string `json:"body" binding:"required,notblank,max=10000"`
+}
+
+// @Summary     Comment on a blog
+// @Description Post a comment on a blog, or a reply to one of its comments by passing the parent_id
+// @ID          Create a comment
+// @Tags  	    Comments
+// @Accept      json
+// @Produce     json
+// @Param       id      path string                   true "blog ID"
+// @Param       request body createCommentRequestBody true "Create comment request body"
+// @Success     201 {object} sqlc.Comment
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/comments [post]
+func (route *CommentRoute) createComment(ctx *gin.Context) {
+	var uri idURI
+	var body createCommentRequestBody
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.JSON(&body)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	comment, err := route.u.CreateComment(ctx, uri.ID, intfaces.CreateCommentParams{
+		Body:     body.Body,
+		ParentID: body.ParentID,
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, comment)
+}
+
+// @Summary     List the comments of a blog
+// @Description Show the comments of a blog depth first, every reply follows the comment it replies to.
+// @Description Deleted comments are kept without their body so that the thread keeps its shape
+// @ID          Blog comments
+// @Tags  	    Comments
+// @Produce     json
+// @Param       id path string true "blog ID"
+// @Param 		Page query string false "1" "The page number that you want items for"
+// @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Param 		count query bool false "Set to false to leave out total_items, total_pages and the last link" default(true)
+// @Success     200 {object} intfaces.ListCommentsResponse
+// @Header      200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /blogs/{id}/comments [get]
+func (route *CommentRoute) blogComments(ctx *gin.Context) {
+	var uri idURI
+	query := pagination.PageQuery{Page: "1", ItemsPerPage: "10"}
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	comments, err := route.u.ListBlogComments(ctx, uri.ID, intfaces.ListCommentsParams{
+		Page:      query.Page,
+		Limit:     query.ItemsPerPage,
+		SkipCount: pagination.SkipCount(query.Count),
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	pagination.SetPageLinks(ctx, &comments.PageMeta)
+
+	ctx.JSON(http.StatusOK, comments)
+}
+
+// @Summary     Fetch a comment
+// @Description Show a single comment
+// @ID          Single comment
+// @Tags  	    Comments
+// @Produce     json
+// @Param       id path string true "comment ID"
+// @Success     200 {object} sqlc.Comment
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /comments/{id} [get]
+func (route *CommentRoute) comment(ctx *gin.Context) {
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	comment, err := route.u.GetComment(ctx, uri.ID)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment)
+}
+
+// @Summary     Fetch the thread of a comment
+// @Description Show a comment followed by all the replies under it depth first
+// @ID          Comment thread
+// @Tags  	    Comments
+// @Produce     json
+// @Param       id path string true "comment ID"
+// @Param 		Page query string false "1" "The page number that you want items for"
+// @Param 		ItemsPerPage query string false "10" "The number of items per page"
+// @Param 		count query bool false "Set to false to leave out total_items, total_pages and the last link" default(true)
+// @Success     200 {object} intfaces.ListCommentsResponse
+// @Header      200 {string} Link "RFC 8288 links to the first, prev, next and last pages"
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /comments/{id}/thread [get]
+func (route *CommentRoute) commentThread(ctx *gin.Context) {
+	var uri idURI
+	query := pagination.PageQuery{Page: "1", ItemsPerPage: "10"}
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.Query(&query)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	comments, err := route.u.ListCommentThread(ctx, uri.ID, intfaces.ListCommentsParams{
+		Page:      query.Page,
+		Limit:     query.ItemsPerPage,
+		SkipCount: pagination.SkipCount(query.Count),
+	})
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	pagination.SetPageLinks(ctx, &comments.PageMeta)
+
+	ctx.JSON(http.StatusOK, comments)
+}
+
+// @Summary     Edit a comment
+// @Description Change the body of a comment, its author can only do so within the edit window after posting it
+// @ID          Edit a comment
+// @Tags  	    Comments
+// @Accept      json
+// @Produce     json
+// @Param       id      path string                 true "comment ID"
+// @Param       request body editCommentRequestBody true "Edit comment request body"
+// @Success     200 {object} sqlc.Comment
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     409 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /comments/{id} [patch]
+func (route *CommentRoute) editComment(ctx *gin.Context) {
+	var uri idURI
+	var body editCommentRequestBody
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.JSON(&body)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	comment, err := route.u.EditComment(ctx, uri.ID, body.Body)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment)
+}
+
+// @Summary     Delete a comment
+// @Description Delete a comment of the caller, or of anyone for moderators. The replies to it stay in the thread
+// @ID          Delete a comment
+// @Tags  	    Comments
+// @Param       id path string true "comment ID"
+// @Success     204
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /comments/{id} [delete]
+func (route *CommentRoute) deleteComment(ctx *gin.Context) {
+	var uri idURI
+
+	if err := validation.Bind(ctx, validation.URI(&uri)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	if err := route.u.DeleteComment(ctx, uri.ID); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,112 @@
+package comment_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/middleware"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/mocks"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	if err := validation.Setup(validation.DefaultMaxPageSize); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestComments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	blogID := uuid.NewString()
+	commentID := uuid.New()
+
+	newRouter := func(mockCommentUsecase *mocks.CommentUsecase) *gin.Engine {
+		handler := CommentRoute{
+			u: mockCommentUsecase,
+			l: logger.New("info"),
+		}
+
+		r := gin.New()
+		r.Use(middleware.ErrorHandler(handler.l))
+		r.POST("/blogs/:id/comments", handler.createComment)
+		r.GET("/comments/:id/thread", handler.commentThread)
+		r.DELETE("/comments/:id", handler.deleteComment)
+
+		return r
+	}
+
+	t.Run("Reply", func(t *testing.T) {
+		mockCommentUsecase := new(mocks.CommentUsecase)
+		mockCommentUsecase.On("CreateComment", mock.Anything, blogID, intfaces.CreateCommentParams{Body: "Agreed", ParentID: commentID.String()}).
+			Return(&sqlc.Comment{ID: uuid.New(), ParentID: uuid.NullUUID{UUID: commentID, Valid: true}, Depth: 1}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/blogs/"+blogID+"/comments", strings.NewReader(`{"body":"Agreed","parent_id":"`+commentID.String()+`"}`))
+		assert.NoError(t, err)
+
+		newRouter(mockCommentUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"depth":1`)
+		mockCommentUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Invalid comment", func(t *testing.T) {
+		mockCommentUsecase := new(mocks.CommentUsecase)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodPost, "/blogs/"+blogID+"/comments", strings.NewReader(`{"body":"  ","parent_id":"1"}`))
+		assert.NoError(t, err)
+
+		newRouter(mockCommentUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.Contains(t, rec.Body.String(), `{"field":"body","in":"body","code":"blank","message":"must not be blank"}`)
+		assert.Contains(t, rec.Body.String(), `{"field":"parent_id","in":"body","code":"invalid_uuid","message":"must be a UUID"}`)
+		mockCommentUsecase.AssertNotCalled(t, "CreateComment", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Thread", func(t *testing.T) {
+		mockCommentUsecase := new(mocks.CommentUsecase)
+		mockCommentUsecase.On("ListCommentThread", mock.Anything, commentID.String(), intfaces.ListCommentsParams{Page: "2", Limit: "5"}).
+			Return(&intfaces.ListCommentsResponse{Comments: []sqlc.Comment{}, PageMeta: intfaces.PageMeta{CurrentPage: 2, ItemsPerPage: 5}}, nil)
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/comments/"+commentID.String()+"/thread?Page=2&ItemsPerPage=5", nil)
+		assert.NoError(t, err)
+
+		newRouter(mockCommentUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Link"), `rel="prev"`)
+		mockCommentUsecase.AssertExpectations(t)
+	})
+
+	t.Run("Delete someone else's comment", func(t *testing.T) {
+		mockCommentUsecase := new(mocks.CommentUsecase)
+		mockCommentUsecase.On("DeleteComment", mock.Anything, commentID.String()).
+			Return(entity.ErrForbidden.WithMessage("only the owner is allowed to comment:delete"))
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodDelete, "/comments/"+commentID.String(), nil)
+		assert.NoError(t, err)
+
+		newRouter(mockCommentUsecase).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		mockCommentUsecase.AssertExpectations(t)
+	})
+}
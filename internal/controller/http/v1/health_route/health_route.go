@@ -0,0 +1,75 @@
+package health_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/health"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"net/http"
+	"time"
+)
+
+type HealthRoute struct {
+	h       *health.Registry
+	verbose bool
+	l       logger.Interface
+}
+
+// NewHealthRoute Initialises the kubernetes probes, they are served outside of the versioned api and without auth.
+// The check errors are only shown with ?verbose when verbose is set -.
+func NewHealthRoute(handler *gin.RouterGroup, h *health.Registry, verbose bool, l logger.Interface) {
+	r := &HealthRoute{h, verbose, l}
+
+	handler.GET("/livez", r.probe(health.Liveness))
+	handler.GET("/readyz", r.probe(health.Readiness))
+	handler.GET("/startupz", r.probe(health.Startup))
+	// Kept for the probes still configured against it -.
+	handler.GET("/health", r.probe(health.Readiness))
+}
+
+type checkResponse struct {
+	Status    health.Status `json:"status"`
+	LatencyMS float64       `json:"latency_ms"`
+	CheckedAt *time.Time    `json:"checked_at,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+type probeResponse struct {
+	Status health.Status            `json:"status"`
+	Checks map[string]checkResponse `json:"checks"`
+}
+
+// probe answers 200 when every check of the probe passes and 503 otherwise. The errors can name hosts and users of
+// the dependencies hence they are only shown with ?verbose on the deployments that enabled it -.
+func (route *HealthRoute) probe(probe health.Probe) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		_, verbose := ctx.GetQuery("verbose")
+		verbose = verbose && route.verbose
+
+		report := route.h.Run(ctx, probe)
+
+		response := probeResponse{Status: report.Status, Checks: make(map[string]checkResponse, len(report.Checks))}
+
+		for name, result := range report.Checks {
+			check := checkResponse{Status: result.Status, LatencyMS: result.LatencyMS}
+
+			if verbose {
+				check.CheckedAt = &result.CheckedAt
+				check.Error = result.Error
+			}
+
+			response.Checks[name] = check
+
+			if result.Status != health.StatusOK {
+				route.l.Warn("http - health - %s: %s failed: %s", probe, name, result.Error)
+			}
+		}
+
+		status := http.StatusOK
+
+		if report.Status != health.StatusOK {
+			status = http.StatusServiceUnavailable
+		}
+
+		ctx.JSON(status, response)
+	}
+}
@@ -0,0 +1,74 @@
+package health_route
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/health"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProbes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	registry := health.New()
+	registry.Register("postgres", func(context.Context) error { return errors.New("dial tcp postgres:5432: connection refused") }, health.Readiness)
+	registry.Register("migrations", func(context.Context) error { return nil }, health.Startup)
+
+	r := gin.New()
+	NewHealthRoute(&r.RouterGroup, registry, true, logger.New("error"))
+
+	for _, tc := range []struct {
+		name   string
+		path   string
+		status int
+		body   string
+	}{
+		{"Liveness without checks", "/livez", http.StatusOK, `{"status":"ok","checks":{}}`},
+		{"Started", "/startupz", http.StatusOK, `"migrations":{"status":"ok"`},
+		{"Not ready", "/readyz", http.StatusServiceUnavailable, `"postgres":{"status":"fail"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(http.MethodGet, tc.path, nil)
+			assert.NoError(t, err)
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.status, rec.Code)
+			assert.Contains(t, rec.Body.String(), tc.body)
+			assert.NotContains(t, rec.Body.String(), "connection refused")
+		})
+	}
+
+	t.Run("Verbose shows the errors", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/readyz?verbose", nil)
+		assert.NoError(t, err)
+
+		r.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"error":"dial tcp postgres:5432: connection refused"`)
+		assert.Contains(t, rec.Body.String(), `"checked_at"`)
+	})
+
+	t.Run("Verbose is ignored unless enabled", func(t *testing.T) {
+		quiet := gin.New()
+		NewHealthRoute(&quiet.RouterGroup, registry, false, logger.New("error"))
+
+		rec := httptest.NewRecorder()
+		req, err := http.NewRequest(http.MethodGet, "/readyz?verbose", nil)
+		assert.NoError(t, err)
+
+		quiet.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "connection refused")
+		assert.NotContains(t, rec.Body.String(), `"checked_at"`)
+	})
+}
@@ -3,13 +3,18 @@ package v1
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/middleware"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/v1/auth_route"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/v1/blog_route"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/v1/comment_route"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/v1/health_route"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/v1/user_route"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
 	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
-	"net/http"
 
 	// Swagger docs -.
 	_ "github.com/harmannkibue/golang_gin_clean_architecture/docs"
@@ -26,10 +31,24 @@ import (
 // @securityDefinitions.basic BasicAuth
 // @in header
 // @name Authorization
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
 func NewRouter(handler *gin.Engine, l logger.Interface, u intfaces.Dependencies) {
-	// Options -.
-	handler.Use(gin.Logger())
-	handler.Use(gin.Recovery())
+	// Lets the usecases read request scoped values such as the caller identity from the gin context -.
+	handler.ContextWithFallback = true
+
+	// The probes and the metrics are scraped every few seconds hence are neither logged nor traced -.
+	scrapedPaths := []string{"/livez", "/readyz", "/startupz", "/health", "/metrics"}
+
+	// Options, the access log is outside of Recovery so that the requests that panicked are logged with their 500.
+	// The span wraps the access log so that its entry carries the trace id -.
+	handler.Use(middleware.ContextLogger(l))
+	handler.Use(middleware.RequestID())
+	handler.Use(middleware.Tracing(scrapedPaths...))
+	handler.Use(middleware.AccessLog(l, scrapedPaths...))
+	handler.Use(middleware.Recovery(l))
+	handler.Use(middleware.ErrorHandler(l))
 
 	//// Swagger ui router group with basic authentication in implemented -.
 	doc := handler.Group("/swagger", gin.BasicAuth(gin.Accounts{
@@ -41,14 +60,12 @@ func NewRouter(handler *gin.Engine, l logger.Interface, u intfaces.Dependencies)
 
 	doc.GET("/*any", swaggerHandler)
 
-	// K8s probe for kubernetes health checks -.
-	handler.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, "The server is up and running.Hurray Blog")
-	})
+	// K8s liveness, readiness and startup probes -.
+	health_route.NewHealthRoute(&handler.RouterGroup, u.Health, u.HealthVerbose, l)
 
 	// Handling a page not found endpoint -.
 	handler.NoRoute(func(c *gin.Context) {
-		c.JSON(404, gin.H{"code": "PAGE_NOT_FOUND", "message": "The requested page is not found.Please try later!"})
+		_ = c.Error(entity.ErrNotFound.WithMessage("The requested page is not found.Please try later!"))
 	})
 
 	// Prometheus metrics
@@ -57,7 +74,13 @@ func NewRouter(handler *gin.Engine, l logger.Interface, u intfaces.Dependencies)
 	// Routers -.
 	unversionedGroup := handler.Group("/api/v1")
 
+	// Routes below require a valid bearer access token -.
+	authenticatedGroup := unversionedGroup.Group("", middleware.Authenticate(u.AuthUsecase))
+
 	{
-		blog_route.NewBlogRoute(unversionedGroup, u.BlogUsecase, l)
+		auth_route.NewAuthRoute(unversionedGroup, u.AuthUsecase, l)
+		blog_route.NewBlogRoute(authenticatedGroup, u.BlogUsecase, u.IdempotencyUsecase, l)
+		comment_route.NewCommentRoute(authenticatedGroup, u.CommentUsecase, l)
+		user_route.NewUserRoute(authenticatedGroup, u.AuthUsecase, l)
 	}
 }
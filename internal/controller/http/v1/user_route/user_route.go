@@ -0,0 +1,71 @@
+package user_route
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/middleware"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/controller/http/validation"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"net/http"
+)
+
+type UserRoute struct {
+	u intfaces.IntAuthUsecase
+	l logger.Interface
+}
+
+// idURI is the ID path parameter of the user routes -.
+type idURI struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// NewUserRoute Initialises a new http router for the administration of the users -.
+func NewUserRoute(handler *gin.RouterGroup, t intfaces.IntAuthUsecase, l logger.Interface) {
+	r := &UserRoute{t, l}
+
+	h := handler.Group("/users")
+	{
+		h.PUT("/:id/role", middleware.RequirePermission(entity.PermissionUserRole), r.setUserRole)
+	}
+}
+
+type userRoleRequestBody struct {
+	Role sqlc.UserRoles `json:"role" binding:"required,oneof=author reader" enums:"author,reader"`
+}
+
+// @Summary     Set the role of a user
+// @Description Grant the author or reader role to a user, it applies once their session is refreshed
+// @ID          Set the role of a user
+// @Tags  	    Users
+// @Accept      json
+// @Produce     json
+// @Param       id      path string              true "user ID"
+// @Param       request body userRoleRequestBody true "User role request body"
+// @Success     200 {object} intfaces.UserResponse
+// @Failure     400 {object} entity.ProblemDetails
+// @Failure     422 {object} entity.ProblemDetails
+// @Failure     404 {object} entity.ProblemDetails
+// @Failure     401 {object} entity.ProblemDetails
+// @Failure     403 {object} entity.ProblemDetails
+// @Security    BearerAuth
+// @Router      /users/{id}/role [put]
+func (route *UserRoute) setUserRole(ctx *gin.Context) {
+	var uri idURI
+	var body userRoleRequestBody
+
+	if err := validation.Bind(ctx, validation.URI(&uri), validation.JSON(&body)); err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	user, err := route.u.SetUserRole(ctx, uri.ID, body.Role)
+
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user)
+}
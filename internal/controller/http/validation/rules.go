@@ -0,0 +1,89 @@
+package validation
+
+import (
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxPageSize is the largest page until Setup is called with the configured one -.
+const DefaultMaxPageSize int32 = 100
+
+var (
+	maxPageSize = DefaultMaxPageSize
+
+	slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+)
+
+// Setup registers the custom rules on the validator gin binds the requests with, it is called once at start up -.
+func Setup(pageSize int32) error {
+	if pageSize <= 0 {
+		return fmt.Errorf("validation - Setup: the max page size must be positive, got %d", pageSize)
+	}
+
+	v, err := engine()
+
+	if err != nil {
+		return err
+	}
+
+	maxPageSize = pageSize
+
+	v.RegisterTagNameFunc(fieldName)
+
+	rules := map[string]validator.Func{
+		"notblank":  notBlank,
+		"page":      page,
+		"page_size": pageSizeRule,
+		"revision":  revision,
+		"slug":      slug,
+	}
+
+	for tag, rule := range rules {
+		if err = v.RegisterValidation(tag, rule); err != nil {
+			return fmt.Errorf("validation - Setup - RegisterValidation %s: %w", tag, err)
+		}
+	}
+
+	return nil
+}
+
+// notBlank fails strings that are empty once the spaces are trimmed, nil pointers are left to omitempty -.
+func notBlank(fl validator.FieldLevel) bool {
+	return strings.TrimSpace(fl.Field().String()) != ""
+}
+
+// page is a page number sent as a query string, 1 is the first page. The offset of the last page of the largest size
+// must still fit the int32 the queries take -.
+func page(fl validator.FieldLevel) bool {
+	number, err := strconv.ParseInt(fl.Field().String(), 10, 32)
+
+	return err == nil && number >= 1 && number <= maxPage()
+}
+
+// maxPage is the last page number whose offset fits an int32 at the max page size -.
+func maxPage() int64 {
+	return math.MaxInt32 / int64(maxPageSize)
+}
+
+// revision is a revision number sent in the path or the query string, 1 is the first revision -.
+func revision(fl validator.FieldLevel) bool {
+	number, err := strconv.ParseInt(fl.Field().String(), 10, 32)
+
+	return err == nil && number >= 1
+}
+
+// pageSizeRule is a number of items per page sent as a query string, at most the configured max page size -.
+func pageSizeRule(fl validator.FieldLevel) bool {
+	size, err := strconv.ParseInt(fl.Field().String(), 10, 32)
+
+	return err == nil && size >= 1 && size <= int64(maxPageSize)
+}
+
+// slug is the format utils.Slugify generates the blog slugs in -.
+func slug(fl validator.FieldLevel) bool {
+	return slugPattern.MatchString(fl.Field().String())
+}
@@ -0,0 +1,164 @@
+// Package validation binds the path, query and body of the requests and reports every invalid field as a 422.
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"reflect"
+	"strings"
+)
+
+// Where the invalid field was sent, reported in entity.FieldError.In -.
+const (
+	InPath  = "path"
+	InQuery = "query"
+	InBody  = "body"
+)
+
+// Binding binds and validates one part of the request into a struct -.
+type Binding struct {
+	in   string
+	bind func(ctx *gin.Context) error
+}
+
+// URI binds the path parameters using the uri struct tags -.
+func URI(obj interface{}) Binding {
+	return Binding{in: InPath, bind: func(ctx *gin.Context) error { return ctx.ShouldBindUri(obj) }}
+}
+
+// Query binds the query parameters using the form struct tags -.
+func Query(obj interface{}) Binding {
+	return Binding{in: InQuery, bind: func(ctx *gin.Context) error { return ctx.ShouldBindQuery(obj) }}
+}
+
+// JSON binds the json request body -.
+func JSON(obj interface{}) Binding {
+	return Binding{in: InBody, bind: func(ctx *gin.Context) error { return ctx.ShouldBindJSON(obj) }}
+}
+
+// Bind runs all the bindings so that the invalid fields of every part of the request are reported together.
+// A body that is not json at all is a 400 as there are no fields to report -.
+func Bind(ctx *gin.Context, bindings ...Binding) error {
+	var fields []entity.FieldError
+
+	for _, b := range bindings {
+		err := b.bind(ctx)
+
+		if err == nil {
+			continue
+		}
+
+		var validationErrs validator.ValidationErrors
+		var typeErr *json.UnmarshalTypeError
+
+		switch {
+		case errors.As(err, &validationErrs):
+			for _, fieldErr := range validationErrs {
+				fields = append(fields, fieldError(b.in, fieldErr))
+			}
+		case errors.As(err, &typeErr) && typeErr.Field != "":
+			fields = append(fields, entity.FieldError{
+				Field:   typeErr.Field,
+				In:      b.in,
+				Code:    "invalid_type",
+				Message: fmt.Sprintf("must be a %s", jsonType(typeErr.Type)),
+			})
+		default:
+			return entity.ErrBadRequest.WithMessage("invalid request " + b.in).Wrap(err)
+		}
+	}
+
+	if len(fields) > 0 {
+		return entity.InvalidFields(fields...)
+	}
+
+	return nil
+}
+
+// fieldError maps a failed rule to its machine readable code and a message -.
+func fieldError(in string, err validator.FieldError) entity.FieldError {
+	field := entity.FieldError{Field: err.Field(), In: in, Code: err.Tag()}
+
+	switch err.Tag() {
+	case "required":
+		field.Message = "is required"
+	case "notblank":
+		field.Code, field.Message = "blank", "must not be blank"
+	case "uuid":
+		field.Code, field.Message = "invalid_uuid", "must be a UUID"
+	case "max":
+		field.Code, field.Message = "too_long", fmt.Sprintf("must be at most %s characters long", err.Param())
+
+		if err.Kind() == reflect.Slice {
+			field.Code, field.Message = "too_many", fmt.Sprintf("must have at most %s items", err.Param())
+		}
+	case "min":
+		field.Code, field.Message = "too_short", fmt.Sprintf("must be at least %s characters long", err.Param())
+	case "boolean":
+		field.Code, field.Message = "invalid_boolean", "must be true or false"
+	case "page":
+		field.Code, field.Message = "invalid_page", fmt.Sprintf("must be a whole number from 1 to %d", maxPage())
+	case "page_size":
+		field.Code, field.Message = "invalid_page_size", fmt.Sprintf("must be a whole number from 1 to %d", maxPageSize)
+	case "revision":
+		field.Code, field.Message = "invalid_revision", "must be a whole number of 1 or more"
+	case "slug":
+		field.Code, field.Message = "invalid_slug", "must only have lowercase letters, digits and single hyphens"
+	case "oneof":
+		field.Code, field.Message = "invalid_choice", "must be one of "+strings.ReplaceAll(err.Param(), " ", ", ")
+	default:
+		field.Message = fmt.Sprintf("failed the %s rule", err.Tag())
+	}
+
+	return field
+}
+
+// jsonType names the go type the way the clients know it -.
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// fieldName reports the fields by the name the client sent them as rather than the go field name -.
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "form", "uri"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// engine is the validator gin validates the bound requests with -.
+func engine() (*validator.Validate, error) {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+
+	if !ok {
+		return nil, errors.New("validation - engine: gin is not using the go-playground validator")
+	}
+
+	return v, nil
+}
@@ -0,0 +1,77 @@
+// Package worker implements the background jobs run alongside the http server.
+package worker
+
+import (
+	"context"
+	"fmt"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var (
+	blogsPublished = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blog_publisher_published_total",
+		Help: "Number of scheduled blogs published by the scheduler.",
+	})
+	blogPublisherRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blog_publisher_runs_total",
+		Help: "Number of scheduler runs by result.",
+	}, []string{"result"})
+	blogPublishLag = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "blog_publisher_lag_seconds",
+		Help:    "Delay between the scheduled publish time of a blog and the time it was published.",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800},
+	})
+	blogPublisherLastSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "blog_publisher_last_success_timestamp_seconds",
+		Help: "Unix time of the last run that completed without an error.",
+	})
+)
+
+// BlogPublisher publishes the scheduled blogs once their publish time is due -.
+type BlogPublisher struct {
+	u         intfaces.IntBlogUsecase
+	l         logger.Interface
+	batchSize int32
+}
+
+// NewBlogPublisher -.
+func NewBlogPublisher(u intfaces.IntBlogUsecase, l logger.Interface, batchSize int32) *BlogPublisher {
+	return &BlogPublisher{u: u, l: l, batchSize: batchSize}
+}
+
+// Run publishes the due blogs in batches until a batch comes back short, meaning nothing more is due -.
+func (p *BlogPublisher) Run(ctx context.Context) error {
+	for ctx.Err() == nil {
+		blogs, err := p.u.PublishDueBlogs(ctx, p.batchSize)
+
+		if err != nil {
+			blogPublisherRuns.WithLabelValues("error").Inc()
+			return fmt.Errorf("worker - BlogPublisher - Run: %w", err)
+		}
+
+		now := time.Now()
+
+		for _, blog := range blogs {
+			blogPublishLag.Observe(now.Sub(blog.PublishedAt.Time).Seconds())
+		}
+
+		blogsPublished.Add(float64(len(blogs)))
+
+		if len(blogs) > 0 {
+			p.l.Info("worker - BlogPublisher - Run: published %d scheduled blogs", len(blogs))
+		}
+
+		if int32(len(blogs)) < p.batchSize {
+			break
+		}
+	}
+
+	blogPublisherRuns.WithLabelValues("success").Inc()
+	blogPublisherLastSuccess.SetToCurrentTime()
+
+	return nil
+}
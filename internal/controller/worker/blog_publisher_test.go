@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/mocks"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBlogPublisherRun(t *testing.T) {
+	due := sqlc.Blog{Status: sqlc.BlogStatusPublished, PublishedAt: sql.NullTime{Time: time.Now().Add(-time.Minute), Valid: true}}
+
+	t.Run("publishes until a batch comes back short", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		ctx := context.Background()
+		mockBlogUsecase.On("PublishDueBlogs", ctx, int32(2)).Return([]sqlc.Blog{due, due}, nil).Once()
+		mockBlogUsecase.On("PublishDueBlogs", ctx, int32(2)).Return([]sqlc.Blog{due}, nil).Once()
+		published := testutil.ToFloat64(blogsPublished)
+
+		err := NewBlogPublisher(mockBlogUsecase, logger.New("info"), 2).Run(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, published+3, testutil.ToFloat64(blogsPublished))
+		mockBlogUsecase.AssertExpectations(t)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		mockBlogUsecase := new(mocks.BlogUsecase)
+		ctx := context.Background()
+		mockBlogUsecase.On("PublishDueBlogs", ctx, int32(2)).Return(nil, errors.New("connection refused")).Once()
+		failures := testutil.ToFloat64(blogPublisherRuns.WithLabelValues("error"))
+
+		err := NewBlogPublisher(mockBlogUsecase, logger.New("info"), 2).Run(ctx)
+
+		assert.Error(t, err)
+		assert.Equal(t, failures+1, testutil.ToFloat64(blogPublisherRuns.WithLabelValues("error")))
+	})
+}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var blogsPurged = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "blog_purger_purged_total",
+	Help: "Number of trashed blogs deleted for good by the scheduler.",
+})
+
+// BlogPurger deletes the blogs trashed for longer than the retention -.
+type BlogPurger struct {
+	u intfaces.IntBlogUsecase
+	l logger.Interface
+}
+
+// NewBlogPurger -.
+func NewBlogPurger(u intfaces.IntBlogUsecase, l logger.Interface) *BlogPurger {
+	return &BlogPurger{u: u, l: l}
+}
+
+// Run -.
+func (p *BlogPurger) Run(ctx context.Context) error {
+	purged, err := p.u.PurgeTrashedBlogs(ctx)
+
+	if err != nil {
+		return fmt.Errorf("worker - BlogPurger - Run: %w", err)
+	}
+
+	blogsPurged.Add(float64(purged))
+
+	if purged > 0 {
+		p.l.Info("worker - BlogPurger - Run: purged %d trashed blogs", purged)
+	}
+
+	return nil
+}
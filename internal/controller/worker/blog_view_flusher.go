@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var blogViewsFlushed = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "blog_view_flusher_flushed_total",
+	Help: "Number of blog views written to the view counters by the scheduler.",
+})
+
+// BlogViewFlusher writes the blog views counted in memory to the database -.
+type BlogViewFlusher struct {
+	u intfaces.IntBlogUsecase
+	l logger.Interface
+}
+
+// NewBlogViewFlusher -.
+func NewBlogViewFlusher(u intfaces.IntBlogUsecase, l logger.Interface) *BlogViewFlusher {
+	return &BlogViewFlusher{u: u, l: l}
+}
+
+// Run -.
+func (f *BlogViewFlusher) Run(ctx context.Context) error {
+	flushed, err := f.u.FlushBlogViews(ctx)
+
+	if err != nil {
+		return fmt.Errorf("worker - BlogViewFlusher - Run: %w", err)
+	}
+
+	blogViewsFlushed.Add(float64(flushed))
+
+	if flushed > 0 {
+		f.l.Debug("worker - BlogViewFlusher - Run: flushed %d blog views", flushed)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var idempotencyKeysPurged = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "idempotency_keys_purged_total",
+	Help: "Number of expired idempotency keys deleted by the scheduler.",
+})
+
+// IdempotencyPurger deletes the idempotency keys past their TTL -.
+type IdempotencyPurger struct {
+	u intfaces.IntIdempotencyUsecase
+	l logger.Interface
+}
+
+// NewIdempotencyPurger -.
+func NewIdempotencyPurger(u intfaces.IntIdempotencyUsecase, l logger.Interface) *IdempotencyPurger {
+	return &IdempotencyPurger{u: u, l: l}
+}
+
+// Run -.
+func (p *IdempotencyPurger) Run(ctx context.Context) error {
+	purged, err := p.u.PurgeExpiredKeys(ctx)
+
+	if err != nil {
+		return fmt.Errorf("worker - IdempotencyPurger - Run: %w", err)
+	}
+
+	idempotencyKeysPurged.Add(float64(purged))
+
+	if purged > 0 {
+		p.l.Info("worker - IdempotencyPurger - Run: purged %d expired idempotency keys", purged)
+	}
+
+	return nil
+}
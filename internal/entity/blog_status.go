@@ -0,0 +1,40 @@
+package entity
+
+// BlogStatus is the publish status of a blog, the values match the blog_status of the database -.
+type BlogStatus string
+
+const (
+	BlogStatusDraft     BlogStatus = "draft"
+	BlogStatusScheduled BlogStatus = "scheduled"
+	BlogStatusPublished BlogStatus = "published"
+	BlogStatusArchived  BlogStatus = "archived"
+)
+
+// blogTransitions is the blog status machine, the statuses a blog in each status may move to -.
+var blogTransitions = map[BlogStatus][]BlogStatus{
+	BlogStatusDraft:     {BlogStatusScheduled, BlogStatusPublished, BlogStatusArchived},
+	BlogStatusScheduled: {BlogStatusDraft, BlogStatusScheduled, BlogStatusPublished, BlogStatusArchived},
+	BlogStatusPublished: {BlogStatusDraft, BlogStatusArchived},
+	BlogStatusArchived:  {BlogStatusDraft},
+}
+
+// CanTransitionBlog reports whether a blog may move from one status to the other -.
+func CanTransitionBlog(from BlogStatus, to BlogStatus) bool {
+	for _, status := range blogTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TransitionBlog returns ErrConflict when the status machine does not allow the move -.
+func TransitionBlog(from BlogStatus, to BlogStatus) error {
+	if !CanTransitionBlog(from, to) {
+		return ErrConflict.WithMessage("a " + string(from) + " blog can not be moved to " + string(to)).
+			WithDetails(map[string]interface{}{"status": from})
+	}
+
+	return nil
+}
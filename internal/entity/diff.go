@@ -0,0 +1,195 @@
+package entity
+
+import "strings"
+
+// _maxDiffEdits bounds the work of DiffLines, texts further apart than this are diffed as the old lines replaced by
+// the new ones which is still a valid if not the shortest diff -.
+const _maxDiffEdits = 1000
+
+// DiffOp is what happened to a line from one text to the other -.
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "equal"
+	DiffInsert DiffOp = "insert"
+	DiffDelete DiffOp = "delete"
+)
+
+// DiffLine is a line of a line level diff, the 1 based line numbers are left out on the side the line is not in -.
+type DiffLine struct {
+	Op      DiffOp `json:"op"`
+	Text    string `json:"text"`
+	OldLine int    `json:"old_line,omitempty"`
+	NewLine int    `json:"new_line,omitempty"`
+}
+
+// DiffLines is the shortest line level diff from one text to the other, the deleted lines come before the lines
+// inserted in their place -.
+func DiffLines(from, to string) []DiffLine {
+	a, b := splitLines(from), splitLines(to)
+
+	// The common head and tail are equal lines, only the middle needs the shortest edit search -.
+	head := 0
+
+	for head < len(a) && head < len(b) && a[head] == b[head] {
+		head++
+	}
+
+	tail := 0
+
+	for tail < len(a)-head && tail < len(b)-head && a[len(a)-1-tail] == b[len(b)-1-tail] {
+		tail++
+	}
+
+	middleA, middleB := a[head:len(a)-tail], b[head:len(b)-tail]
+
+	ops := shortestEdit(middleA, middleB)
+
+	if ops == nil {
+		ops = replaceAll(len(middleA), len(middleB))
+	}
+
+	lines := make([]DiffLine, 0, head+len(ops)+tail)
+
+	for i := 0; i < head; i++ {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: a[i], OldLine: i + 1, NewLine: i + 1})
+	}
+
+	x, y := 0, 0
+
+	for _, op := range ops {
+		switch op {
+		case DiffEqual:
+			lines = append(lines, DiffLine{Op: op, Text: middleA[x], OldLine: head + x + 1, NewLine: head + y + 1})
+			x++
+			y++
+		case DiffDelete:
+			lines = append(lines, DiffLine{Op: op, Text: middleA[x], OldLine: head + x + 1})
+			x++
+		case DiffInsert:
+			lines = append(lines, DiffLine{Op: op, Text: middleB[y], NewLine: head + y + 1})
+			y++
+		}
+	}
+
+	for i := tail; i > 0; i-- {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: a[len(a)-i], OldLine: len(a) - i + 1, NewLine: len(b) - i + 1})
+	}
+
+	return lines
+}
+
+// shortestEdit is the Myers algorithm, it returns the ops turning a into b or nil when they need more than
+// _maxDiffEdits edits -.
+func shortestEdit(a, b []string) []DiffOp {
+	n, m := len(a), len(b)
+	offset := n + m
+	// v holds the furthest x reached on each diagonal k = x - y, trace keeps v[-d..d] of every d for the backtrack -.
+	v := make([]int, 2*offset+2)
+	var trace [][]int
+
+	for d := 0; d <= n+m && d <= _maxDiffEdits; d++ {
+		snapshot := make([]int, 2*d+1)
+		copy(snapshot, v[offset-d:offset+d+1])
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+
+			if k == -d || k != d && v[offset+k-1] < v[offset+k+1] {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+
+			y := x - k
+
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, n, m)
+			}
+		}
+	}
+
+	return nil
+}
+
+// backtrack walks the trace back from the end of both texts to recover the ops -.
+func backtrack(trace [][]int, x, y int) []DiffOp {
+	var ops []DiffOp
+
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		var prevK int
+
+		if k == -d || k != d && v[k-1+d] < v[k+1+d] {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+
+		prevX := 0
+
+		if d > 0 {
+			prevX = v[prevK+d]
+		}
+
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			ops = append(ops, DiffEqual)
+			x--
+			y--
+		}
+
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, DiffInsert)
+			} else {
+				ops = append(ops, DiffDelete)
+			}
+		}
+
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+
+	return ops
+}
+
+// replaceAll deletes all the old lines and inserts all the new ones -.
+func replaceAll(deleted, inserted int) []DiffOp {
+	ops := make([]DiffOp, 0, deleted+inserted)
+
+	for i := 0; i < deleted; i++ {
+		ops = append(ops, DiffDelete)
+	}
+
+	for i := 0; i < inserted; i++ {
+		ops = append(ops, DiffInsert)
+	}
+
+	return ops
+}
+
+// splitLines an empty text has no lines and a trailing newline does not start another one -.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	lines := strings.Split(strings.TrimSuffix(strings.ReplaceAll(text, "\r\n", "\n"), "\n"), "\n")
+
+	return lines
+}
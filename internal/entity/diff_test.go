@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		from string
+		to   string
+		want []DiffLine
+	}{
+		{"Both empty", "", "", []DiffLine{}},
+		{"Empty to text", "", "a\nb", []DiffLine{
+			{Op: DiffInsert, Text: "a", NewLine: 1},
+			{Op: DiffInsert, Text: "b", NewLine: 2},
+		}},
+		{"Text to empty", "a\nb", "", []DiffLine{
+			{Op: DiffDelete, Text: "a", OldLine: 1},
+			{Op: DiffDelete, Text: "b", OldLine: 2},
+		}},
+		{"Common head and tail", "head\nold\ntail", "head\nnew\ntail", []DiffLine{
+			{Op: DiffEqual, Text: "head", OldLine: 1, NewLine: 1},
+			{Op: DiffDelete, Text: "old", OldLine: 2},
+			{Op: DiffInsert, Text: "new", NewLine: 2},
+			{Op: DiffEqual, Text: "tail", OldLine: 3, NewLine: 3},
+		}},
+		{"Lines kept in the middle", "a\nb\nc", "b\nc\nd", []DiffLine{
+			{Op: DiffDelete, Text: "a", OldLine: 1},
+			{Op: DiffEqual, Text: "b", OldLine: 2, NewLine: 1},
+			{Op: DiffEqual, Text: "c", OldLine: 3, NewLine: 2},
+			{Op: DiffInsert, Text: "d", NewLine: 3},
+		}},
+		{"CRLF equals LF", "a\r\nb\r\n", "a\nb\n", []DiffLine{
+			{Op: DiffEqual, Text: "a", OldLine: 1, NewLine: 1},
+			{Op: DiffEqual, Text: "b", OldLine: 2, NewLine: 2},
+		}},
+		{"Trailing newline is not a line", "a\nb\n", "a\nb", []DiffLine{
+			{Op: DiffEqual, Text: "a", OldLine: 1, NewLine: 1},
+			{Op: DiffEqual, Text: "b", OldLine: 2, NewLine: 2},
+		}},
+		{"Blank line at the end is a line", "a\n\n", "a", []DiffLine{
+			{Op: DiffEqual, Text: "a", OldLine: 1, NewLine: 1},
+			{Op: DiffDelete, Text: "", OldLine: 2},
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, DiffLines(tc.from, tc.to))
+		})
+	}
+
+	t.Run("Texts too far apart are replaced whole", func(t *testing.T) {
+		from := make([]string, _maxDiffEdits)
+		to := make([]string, _maxDiffEdits)
+
+		for i := range from {
+			from[i] = fmt.Sprintf("old %d", i)
+			to[i] = fmt.Sprintf("new %d", i)
+		}
+
+		lines := DiffLines("same\n"+strings.Join(from, "\n"), "same\n"+strings.Join(to, "\n"))
+
+		if assert.Len(t, lines, 1+2*_maxDiffEdits) {
+			assert.Equal(t, DiffLine{Op: DiffEqual, Text: "same", OldLine: 1, NewLine: 1}, lines[0])
+			assert.Equal(t, DiffLine{Op: DiffDelete, Text: "old 0", OldLine: 2}, lines[1])
+			assert.Equal(t, DiffLine{Op: DiffDelete, Text: fmt.Sprintf("old %d", _maxDiffEdits-1), OldLine: _maxDiffEdits + 1}, lines[_maxDiffEdits])
+			assert.Equal(t, DiffLine{Op: DiffInsert, Text: "new 0", NewLine: 2}, lines[_maxDiffEdits+1])
+			assert.Equal(t, DiffLine{Op: DiffInsert, Text: fmt.Sprintf("new %d", _maxDiffEdits-1), NewLine: _maxDiffEdits + 1}, lines[2*_maxDiffEdits])
+		}
+	})
+}
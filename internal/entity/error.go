@@ -1,78 +1,141 @@
 package entity
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/sirupsen/logrus"
 	"net/http"
-	"strings"
 )
 
 var (
-	ErrInternalServerError = errors.New("INTERNAL_SERVER_ERROR")
-	ErrNotFound            = errors.New("NOT_FOUND")
-	ErrBadRequest          = errors.New("BAD_REQUEST")
-	ErrConflict            = errors.New("CONFLICT")
-	ErrInsufficientFund    = errors.New("INSUFFICIENT_FUND")
-	ErrUnauthorized        = errors.New("UNAUTHORIZED")
-	errStruct              ErrorCodesStruct
+	ErrInternalServerError = NewAppError("INTERNAL_SERVER_ERROR", http.StatusInternalServerError, "internal server error")
+	ErrNotFound            = NewAppError("NOT_FOUND", http.StatusNotFound, "resource not found")
+	ErrBadRequest          = NewAppError("BAD_REQUEST", http.StatusBadRequest, "bad request")
+	ErrConflict            = NewAppError("CONFLICT", http.StatusConflict, "conflict")
+	ErrInsufficientFund    = NewAppError("INSUFFICIENT_FUND", http.StatusBadRequest, "insufficient fund")
+	ErrUnauthorized        = NewAppError("UNAUTHORIZED", http.StatusUnauthorized, "unauthorized")
+	ErrForbidden           = NewAppError("FORBIDDEN", http.StatusForbidden, "forbidden")
+	ErrValidation          = NewAppError("VALIDATION_FAILED", http.StatusUnprocessableEntity, "the request has invalid fields")
+	ErrIdempotencyKeyReuse = NewAppError("IDEMPOTENCY_KEY_REUSED", http.StatusUnprocessableEntity, "the idempotency key was used for another request")
+	ErrPreconditionFailed  = NewAppError("PRECONDITION_FAILED", http.StatusPreconditionFailed, "precondition failed")
+	ErrPreconditionMissing = NewAppError("PRECONDITION_REQUIRED", http.StatusPreconditionRequired, "precondition required")
+	ErrPayloadTooLarge     = NewAppError("PAYLOAD_TOO_LARGE", http.StatusRequestEntityTooLarge, "the request body is too large")
 )
 
-// ErrorCodesStruct This is the struct for the error codes -.
-type ErrorCodesStruct struct {
-	ErrorCode    string `json:"error_code"`
-	ErrorMessage string `json:"error_message"`
+// FieldError is one invalid field of a request, Code is stable for clients to match on while Message is for people -.
+type FieldError struct {
+	Field   string `json:"field"`
+	In      string `json:"in"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// CreateError Creates the error code -.
-func CreateError(code string, message string) error {
+// InvalidFields returns a validation error listing every invalid field under the errors detail -.
+func InvalidFields(fields ...FieldError) *AppError {
+	return ErrValidation.WithDetails(map[string]interface{}{"errors": fields})
+}
 
-	err := fmt.Sprintf(`{"error_code": "%s", "error_message": "%s"}`, code, message)
+// AppError is the typed domain error returned by the usecases.
+// Errors of the same kind share a Code, so errors.Is(err, ErrNotFound) matches any not found error -.
+type AppError struct {
+	Code    string
+	Message string
+	Status  int
+	Details map[string]interface{}
+	Err     error
+}
 
-	return errors.New(err)
+// NewAppError creates a new kind of error, used to declare the sentinel errors above -.
+func NewAppError(code string, status int, message string) *AppError {
+	return &AppError{Code: code, Status: status, Message: message}
 }
 
-// GetStatusCode Fetched the status code from the error -.
-func GetStatusCode(err error) int {
-	if err == nil {
-		return http.StatusOK
-	}
-	errCode := extractErroCode(err)
-
-	logrus.Error(err)
-	switch errCode {
-	case ErrInternalServerError.Error():
-		return http.StatusInternalServerError
-	case ErrNotFound.Error():
-		return http.StatusNotFound
-	case ErrConflict.Error():
-		return http.StatusConflict
-	case ErrInsufficientFund.Error():
-		return http.StatusBadRequest
-	case ErrUnauthorized.Error():
-		return http.StatusUnauthorized
-	case ErrBadRequest.Error():
-		return http.StatusBadRequest
-	default:
-		return http.StatusInternalServerError
+func (e *AppError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
 	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap exposes the cause to errors.Is and errors.As -.
+func (e *AppError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the target is an AppError of the same kind -.
+func (e *AppError) Is(target error) bool {
+	t, ok := target.(*AppError)
+
+	return ok && t.Code == e.Code
 }
 
-// Gets the error and extracts the error code from its json string -.
-func extractErroCode(err error) string {
-	s := err.Error()
+// WithMessage returns a copy of the error with a client facing message -.
+func (e *AppError) WithMessage(message string) *AppError {
+	c := *e
+	c.Message = message
 
-	_ = json.Unmarshal([]byte(s), &errStruct)
+	return &c
+}
+
+// WithDetails returns a copy of the error carrying extra details for the client -.
+func (e *AppError) WithDetails(details map[string]interface{}) *AppError {
+	c := *e
+	c.Details = details
 
-	return strings.ToUpper(errStruct.ErrorCode)
+	return &c
 }
 
-// ErrorCodeResponse The response message for the error -.
-func ErrorCodeResponse(err error) ErrorCodesStruct {
-	s := err.Error()
+// Wrap returns a copy of the error with the underlying cause attached -.
+func (e *AppError) Wrap(cause error) *AppError {
+	c := *e
+	c.Err = cause
+
+	return &c
+}
 
-	_ = json.Unmarshal([]byte(s), &errStruct)
+// AsAppError returns the AppError in the chain, errors of any other type are internal server errors -.
+func AsAppError(err error) *AppError {
+	var appErr *AppError
 
-	return errStruct
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	return ErrInternalServerError.Wrap(err)
+}
+
+// GetStatusCode Fetched the status code from the error. It does not log the error, middleware.ErrorHandler logs
+// it once with the fields of the request -.
+func GetStatusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	return AsAppError(err).Status
+}
+
+// ProblemDetails is the RFC 7807 application/problem+json response body -.
+type ProblemDetails struct {
+	Type     string                 `json:"type"`
+	Title    string                 `json:"title"`
+	Status   int                    `json:"status"`
+	Detail   string                 `json:"detail,omitempty"`
+	Instance string                 `json:"instance,omitempty"`
+	Code     string                 `json:"code"`
+	Details  map[string]interface{} `json:"details,omitempty"`
+}
+
+// NewProblemDetails renders the error for the client. Causes are never exposed as they may leak internals -.
+func NewProblemDetails(err error, instance string) ProblemDetails {
+	appErr := AsAppError(err)
+
+	return ProblemDetails{
+		Type:     "about:blank",
+		Title:    http.StatusText(appErr.Status),
+		Status:   appErr.Status,
+		Detail:   appErr.Message,
+		Instance: instance,
+		Code:     appErr.Code,
+		Details:  appErr.Details,
+	}
 }
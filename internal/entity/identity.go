@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// Role is the role of a user, the values match the user_roles of the database -.
+type Role string
+
+const (
+	RoleAuthor Role = "author"
+	RoleReader Role = "reader"
+	RoleAdmin  Role = "admin"
+)
+
+// Identity is the authenticated caller of a request -.
+type Identity struct {
+	UserID uuid.UUID
+	Email  string
+	Role   Role
+}
+
+type identityKey struct{}
+
+// ContextWithIdentity returns a copy of the context carrying the caller identity -.
+func ContextWithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the caller identity, ok is false for anonymous requests -.
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+
+	return identity, ok && identity != nil
+}
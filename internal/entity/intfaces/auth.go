@@ -0,0 +1,41 @@
+package intfaces
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"time"
+)
+
+type IntAuthUsecase interface {
+	Register(ctx context.Context, args RegisterParams) (*UserResponse, error)
+	Login(ctx context.Context, email string, password string) (*AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*AuthTokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+	// SetUserRole grants the author or reader role to a user, new users always register as readers -.
+	SetUserRole(ctx context.Context, id string, role sqlc.UserRoles) (*UserResponse, error)
+	// Authenticate verifies an access token and returns the identity of its holder -.
+	Authenticate(ctx context.Context, accessToken string) (*entity.Identity, error)
+}
+
+type RegisterParams struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// UserResponse is the user as returned to clients, it never carries the password hash -.
+type UserResponse struct {
+	ID        uuid.UUID      `json:"id"`
+	Email     string         `json:"email"`
+	Role      sqlc.UserRoles `json:"role"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+type AuthTokens struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	// ExpiresIn is the access token lifetime in seconds -.
+	ExpiresIn int64 `json:"expires_in"`
+}
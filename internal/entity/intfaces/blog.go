@@ -2,22 +2,209 @@ package intfaces
 
 import (
 	"context"
+	"encoding/json"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"time"
 )
 
 type IntBlogUsecase interface {
 	GetBlog(ctx context.Context, id string) (*sqlc.Blog, error)
-	CreateBlog(ctx context.Context, description string) (*sqlc.Blog, error)
+	GetBlogBySlug(ctx context.Context, slug string) (*sqlc.Blog, error)
+	CreateBlog(ctx context.Context, args CreateBlogParams) (*sqlc.Blog, error)
 	ListBlogs(ctx context.Context, args ListBlogsParams) (*ListBlogsResponse, error)
+	SearchBlogs(ctx context.Context, args SearchBlogsParams) (*SearchBlogsResponse, error)
+	// The changes of a blog are only made when the precondition matches the entity.ETag of the blog, the clients send
+	// it in the If-Match header -.
+	UpdateBlog(ctx context.Context, id string, args UpdateBlogParams, precondition entity.Precondition) (*sqlc.Blog, error)
+	PatchBlog(ctx context.Context, id string, args PatchBlogParams, precondition entity.Precondition) (*sqlc.Blog, error)
+	// DeleteBlog moves the blog to the trash, it can be restored until it is purged -.
+	DeleteBlog(ctx context.Context, id string, precondition entity.Precondition) error
+	PublishBlog(ctx context.Context, id string, publishAt *time.Time, precondition entity.Precondition) (*sqlc.Blog, error)
+	UnpublishBlog(ctx context.Context, id string, precondition entity.Precondition) (*sqlc.Blog, error)
+	ArchiveBlog(ctx context.Context, id string, precondition entity.Precondition) (*sqlc.Blog, error)
+	// PublishDueBlogs is run by the scheduler rather than a user hence is not authorized -.
+	PublishDueBlogs(ctx context.Context, limit int32) ([]sqlc.Blog, error)
+	ListTrashedBlogs(ctx context.Context, args ListTrashedBlogsParams) (*ListBlogsResponse, error)
+	RestoreBlog(ctx context.Context, id string) (*sqlc.Blog, error)
+	// PurgeTrashedBlogs deletes the blogs trashed longer than the retention for good, it is run by the scheduler -.
+	PurgeTrashedBlogs(ctx context.Context) (int64, error)
+	// The revisions of a blog are only shown to its author, the revision numbers are the versions that introduced them -.
+	ListBlogRevisions(ctx context.Context, id string, args ListBlogRevisionsParams) (*ListBlogRevisionsResponse, error)
+	GetBlogRevision(ctx context.Context, id string, revision string) (*sqlc.BlogRevision, error)
+	DiffBlogRevisions(ctx context.Context, id string, from string, to string) (*BlogRevisionDiff, error)
+	RestoreBlogRevision(ctx context.Context, id string, revision string, precondition entity.Precondition) (*sqlc.Blog, error)
+	// ListTags lists the tags with the number of published blogs using them -.
+	ListTags(ctx context.Context, args ListTagsParams) (*ListTagsResponse, error)
+	ListBlogTags(ctx context.Context, id string) ([]sqlc.Tag, error)
+	// ViewBlog fetches a blog like GetBlog does and counts the view of the caller -.
+	ViewBlog(ctx context.Context, id string) (*BlogView, error)
+	// ReactToBlog sets the reaction of the caller, reacting again replaces their earlier reaction -.
+	ReactToBlog(ctx context.Context, id string, kind string) (*BlogStats, error)
+	RemoveBlogReaction(ctx context.Context, id string) (*BlogStats, error)
+	// ListPopularBlogs lists the published blogs by their views and reactions, recent ones weighing more -.
+	ListPopularBlogs(ctx context.Context, args ListPopularBlogsParams) (*ListPopularBlogsResponse, error)
+	// FlushBlogViews writes the views buffered in memory to the database, it is run by the scheduler -.
+	FlushBlogViews(ctx context.Context) (int64, error)
+}
+
+// CreateBlogParams the slug is generated from the title and an empty summary is stored as null.
+// The tags are matched by their slug and created when they do not exist yet -.
+type CreateBlogParams struct {
+	Title       string   `json:"title"`
+	Body        string   `json:"body"`
+	Summary     string   `json:"summary"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// UpdateBlogParams replaces all the editable fields, the slug is kept so that links to the blog keep working -.
+type UpdateBlogParams struct {
+	Title       string `json:"title"`
+	Body        string `json:"body"`
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+	// Tags replace the tags of the blog, nil keeps them and an empty list removes them all -.
+	Tags []string `json:"tags"`
+}
+
+// PatchBlogParams Only the non nil fields are updated on the blog -.
+type PatchBlogParams struct {
+	Title       *string `json:"title"`
+	Body        *string `json:"body"`
+	Summary     *string `json:"summary"`
+	Description *string `json:"description"`
+	// Tags replace the tags of the blog, nil keeps them and an empty list removes them all -.
+	Tags []string `json:"tags"`
+}
+
+// ListBlogsSortFields whitelists the fields the blogs can be sorted by, a leading - sorts them in descending order -.
+var ListBlogsSortFields = []string{"created_at", "updated_at", "published_at", "title"}
+
+// ListBlogsFilterFields whitelists the filters of the blogs listing -.
+var ListBlogsFilterFields = []string{"status", "user_role", "created_after", "created_before"}
+
+// ListBlogsFields whitelists the fields that can be selected, mapped to their json keys -.
+var ListBlogsFields = map[string]string{
+	"id":           "id",
+	"title":        "title",
+	"slug":         "slug",
+	"body":         "body",
+	"summary":      "summary",
+	"descriptions": "descriptions",
+	"status":       "status",
+	"user_role":    "userRole",
+	"author_id":    "authorId",
+	"created_at":   "createdAt",
+	"updated_at":   "updatedAt",
+	"published_at": "publishedAt",
 }
 
 type ListBlogsParams struct {
 	Page  string `json:"page"`
 	Limit string `json:"limit"`
+	// UseCursor switches to keyset pagination, an empty Cursor then fetches the first page -.
+	UseCursor bool   `json:"use_cursor"`
+	Cursor    string `json:"cursor"`
+	// AuthorID optionally restricts the listing to the blogs of one author -.
+	AuthorID string `json:"author_id"`
+	// Sort is a comma separated list of ListBlogsSortFields e.g -created_at,title -.
+	Sort string `json:"sort"`
+	// Filters are keyed by ListBlogsFilterFields -.
+	Filters map[string]string `json:"filters"`
+	// Tags restricts the listing to the blogs tagged with any or, when TagMatch is all, all of the tags -.
+	Tags     []string `json:"tags"`
+	TagMatch string   `json:"tag_match"`
+	// Fields is a comma separated list of ListBlogsFields, empty returns all the fields -.
+	Fields string `json:"fields"`
+	// SkipCount leaves out the COUNT query and hence the totals, counting is slow on huge tables -.
+	SkipCount bool `json:"skip_count"`
+}
+
+// PageMeta is the pagination metadata of a listing, the totals are left out when the count is skipped and
+// the page numbers in cursor mode. The links are absolute urls built from the request by the http layer -.
+type PageMeta struct {
+	CurrentPage  int32  `json:"current_page,omitempty"`
+	ItemsPerPage int32  `json:"items_per_page,omitempty"`
+	TotalItems   *int64 `json:"total_items,omitempty"`
+	TotalPages   *int64 `json:"total_pages,omitempty"`
+	// HasNext is set when there are more items after this page -.
+	HasNext  bool   `json:"-"`
+	Next     string `json:"next,omitempty"`
+	Previous string `json:"previous,omitempty"`
+	First    string `json:"first,omitempty"`
+	Last     string `json:"last,omitempty"`
 }
 
 type ListBlogsResponse struct {
 	Blog         []sqlc.Blog `json:"blogs"`
 	NextPage     string      `json:"next_page"`
 	PreviousPage string      `json:"previous_page"`
+	NextCursor   string      `json:"next_cursor,omitempty"`
+	PrevCursor   string      `json:"prev_cursor,omitempty"`
+	PageMeta
+	// Fields are the json keys of the blog fields to render, empty renders them all -.
+	Fields []string `json:"-"`
+}
+
+// MarshalJSON renders only the selected Fields of the blogs -.
+func (r ListBlogsResponse) MarshalJSON() ([]byte, error) {
+	type response ListBlogsResponse
+
+	if len(r.Fields) == 0 {
+		return json.Marshal(response(r))
+	}
+
+	blogs := make([]map[string]json.RawMessage, len(r.Blog))
+
+	for i, blog := range r.Blog {
+		encoded, err := json.Marshal(blog)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var all map[string]json.RawMessage
+
+		if err = json.Unmarshal(encoded, &all); err != nil {
+			return nil, err
+		}
+
+		blogs[i] = make(map[string]json.RawMessage, len(r.Fields))
+
+		for _, field := range r.Fields {
+			blogs[i][field] = all[field]
+		}
+	}
+
+	return json.Marshal(struct {
+		response
+		Blog []map[string]json.RawMessage `json:"blogs"`
+	}{response(r), blogs})
+}
+
+// SearchBlogsParams pages through the search results the same way ListBlogsParams does in page mode -.
+type SearchBlogsParams struct {
+	Query string `json:"query"`
+	Page  string `json:"page"`
+	Limit string `json:"limit"`
+	// SkipCount leaves out the COUNT query and hence the totals -.
+	SkipCount bool `json:"skip_count"`
+}
+
+// ListTrashedBlogsParams pages through the trash the same way ListBlogsParams does in page mode, latest deleted first -.
+type ListTrashedBlogsParams struct {
+	Page  string `json:"page"`
+	Limit string `json:"limit"`
+	// AuthorID optionally restricts the listing to the blogs of one author -.
+	AuthorID string `json:"author_id"`
+	// SkipCount leaves out the COUNT query and hence the totals -.
+	SkipCount bool `json:"skip_count"`
+}
+
+type SearchBlogsResponse struct {
+	Blog         []sqlc.SearchBlogsRow `json:"blogs"`
+	NextPage     string                `json:"next_page"`
+	PreviousPage string                `json:"previous_page"`
+	PageMeta
 }
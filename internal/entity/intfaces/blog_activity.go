@@ -0,0 +1,32 @@
+package intfaces
+
+import "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+
+// BlogStats are the views and reactions of a blog, the views still buffered in memory are included -.
+type BlogStats struct {
+	Views int64 `json:"views"`
+	// Reactions are keyed by sqlc.ReactionKind, the kinds nobody used are left out -.
+	Reactions map[sqlc.ReactionKind]int64 `json:"reactions"`
+	// MyReaction is the reaction of the caller, empty when they did not react -.
+	MyReaction sqlc.ReactionKind `json:"my_reaction,omitempty"`
+}
+
+// BlogView is a blog as it is shown to its readers, with its stats -.
+type BlogView struct {
+	sqlc.Blog
+	Stats BlogStats `json:"stats"`
+}
+
+// ListPopularBlogsParams pages through the popular blogs the same way ListBlogsParams does in page mode.
+// There are no totals as the scores change with every view -.
+type ListPopularBlogsParams struct {
+	Page  string `json:"page"`
+	Limit string `json:"limit"`
+}
+
+type ListPopularBlogsResponse struct {
+	Blogs        []sqlc.ListPopularBlogsRow `json:"blogs"`
+	NextPage     string                     `json:"next_page"`
+	PreviousPage string                     `json:"previous_page"`
+	PageMeta
+}
@@ -0,0 +1,35 @@
+package intfaces
+
+import (
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+)
+
+// ListBlogRevisionsParams pages through the revisions of a blog the same way ListBlogsParams does in page mode,
+// latest first -.
+type ListBlogRevisionsParams struct {
+	Page  string `json:"page"`
+	Limit string `json:"limit"`
+	// SkipCount leaves out the COUNT query and hence the totals -.
+	SkipCount bool `json:"skip_count"`
+}
+
+type ListBlogRevisionsResponse struct {
+	Revisions    []sqlc.ListBlogRevisionsRow `json:"revisions"`
+	NextPage     string                      `json:"next_page"`
+	PreviousPage string                      `json:"previous_page"`
+	PageMeta
+}
+
+// BlogRevisionDiff is the line level diff from one revision of a blog to another, only the changed fields are listed -.
+type BlogRevisionDiff struct {
+	From    int32           `json:"from"`
+	To      int32           `json:"to"`
+	Changes []BlogFieldDiff `json:"changes"`
+}
+
+// BlogFieldDiff is the diff of one field, the unchanged lines are kept as context -.
+type BlogFieldDiff struct {
+	Field string            `json:"field"`
+	Lines []entity.DiffLine `json:"lines"`
+}
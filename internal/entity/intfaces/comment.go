@@ -0,0 +1,42 @@
+package intfaces
+
+import (
+	"context"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+)
+
+// IntCommentUsecase the comments of a blog are seen by whoever can see the blog. Deleted comments stay in their
+// thread without their content so that the replies to them keep their place -.
+type IntCommentUsecase interface {
+	CreateComment(ctx context.Context, blogID string, args CreateCommentParams) (*sqlc.Comment, error)
+	GetComment(ctx context.Context, id string) (*sqlc.Comment, error)
+	// ListBlogComments lists all the comments of a blog depth first, the replies follow the comment they reply to -.
+	ListBlogComments(ctx context.Context, blogID string, args ListCommentsParams) (*ListCommentsResponse, error)
+	// ListCommentThread lists a comment followed by all the replies under it depth first -.
+	ListCommentThread(ctx context.Context, id string, args ListCommentsParams) (*ListCommentsResponse, error)
+	// EditComment changes the body of a comment, only its author can and only within the configured edit window -.
+	EditComment(ctx context.Context, id string, body string) (*sqlc.Comment, error)
+	// DeleteComment can be done by the author of the comment or a moderator -.
+	DeleteComment(ctx context.Context, id string) error
+}
+
+// CreateCommentParams an empty ParentID posts a top level comment rather than a reply -.
+type CreateCommentParams struct {
+	Body     string `json:"body"`
+	ParentID string `json:"parent_id"`
+}
+
+// ListCommentsParams pages through a thread the same way ListBlogsParams does in page mode -.
+type ListCommentsParams struct {
+	Page  string `json:"page"`
+	Limit string `json:"limit"`
+	// SkipCount leaves out the COUNT query and hence the totals -.
+	SkipCount bool `json:"skip_count"`
+}
+
+type ListCommentsResponse struct {
+	Comments     []sqlc.Comment `json:"comments"`
+	NextPage     string         `json:"next_page"`
+	PreviousPage string         `json:"previous_page"`
+	PageMeta
+}
@@ -1,10 +1,21 @@
 package intfaces
 
-import "github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+import (
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/health"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+)
 
 // Dependencies holds all injected dependencies -.
 type Dependencies struct {
 	Logger logger.Interface
 	// Register all the usecases below for dependency injection -.
 	BlogUsecase IntBlogUsecase
+	AuthUsecase IntAuthUsecase
+	// IdempotencyUsecase backs the Idempotency-Key middleware -.
+	IdempotencyUsecase IntIdempotencyUsecase
+	CommentUsecase     IntCommentUsecase
+	// Health runs the checks behind the kubernetes probes -.
+	Health *health.Registry
+	// HealthVerbose lets the probes show the check errors with ?verbose -.
+	HealthVerbose bool
 }
@@ -0,0 +1,29 @@
+package intfaces
+
+import "context"
+
+type IntIdempotencyUsecase interface {
+	// Begin claims the key for the request, when the key was already used for the same request its saved response is
+	// returned to be replayed instead -.
+	Begin(ctx context.Context, key string, request IdempotentRequest) (*IdempotentResponse, error)
+	// Complete saves the response so that retries with the key replay it -.
+	Complete(ctx context.Context, key string, response IdempotentResponse) error
+	// Release forgets the key of a failed request so that the client can retry it -.
+	Release(ctx context.Context, key string) error
+	// PurgeExpiredKeys is run by the scheduler rather than a user hence is not authorized -.
+	PurgeExpiredKeys(ctx context.Context) (int64, error)
+}
+
+// IdempotentRequest is what a key is bound to, a retry must send the same method, path and body -.
+type IdempotentRequest struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// IdempotentResponse is the saved response of a request sent with an Idempotency-Key -.
+type IdempotentResponse struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
@@ -3,48 +3,151 @@ package intfaces
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/tracing"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+const (
+	_defaultTxRetries = 3
+	_txRetryBackoff   = 20 * time.Millisecond
+	// Postgres SQLSTATE returned when a serializable transaction can not be committed -.
+	_serializationFailure = "40001"
 )
 
 // Store This interface helps to mock the database during testing -.
 type Store interface {
 	sqlc.Querier
+	// WithTx runs fn in a single transaction, committing when fn returns nil and rolling back otherwise.
+	// The querier passed to fn is itself a Store, calling its WithTx nests the work in a savepoint -.
+	WithTx(ctx context.Context, opts *TxOptions, fn func(q sqlc.Querier) error) error
+}
+
+// TxOptions configures a transaction started by WithTx, nil uses the postgres defaults -.
+type TxOptions struct {
+	// Isolation defaults to read committed in postgres -.
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+	// MaxRetries is the number of times fn is retried on serialization failures, 0 defaults to 3 and NoTxRetries
+	// runs fn only once -.
+	MaxRetries int
 }
 
+// NoTxRetries is the MaxRetries of the transactions whose serialization failures are left to the caller -.
+const NoTxRetries = -1
+
 // SqlStore provides all functions to execute db queries as well as transactions
 type SqlStore struct {
 	*sqlc.Queries
 	db *sql.DB
 }
 
-// NewStore SqlStore creates a new SqlStore
+// NewStore SqlStore creates a new SqlStore, every query runs in a span of its own -.
 func NewStore(db *sql.DB) Store {
 	return &SqlStore{
 		db:      db,
-		Queries: sqlc.New(db),
+		Queries: sqlc.New(&tracedDB{db: db}),
+	}
+}
+
+// WithTx starts a transaction and retries fn when postgres reports a serialization failure -.
+func (store *SqlStore) WithTx(ctx context.Context, opts *TxOptions, fn func(q sqlc.Querier) error) error {
+	if opts == nil {
+		opts = &TxOptions{}
+	}
+
+	retries := opts.MaxRetries
+
+	if retries == 0 {
+		retries = _defaultTxRetries
+	}
+
+	txOpts := &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly}
+
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		err = store.execTx(ctx, txOpts, func(tx *sql.Tx) error {
+			traced := &tracedDB{db: tx}
+
+			return fn(&txStore{Queries: sqlc.New(traced), tx: traced})
+		})
+
+		if !isSerializationFailure(err) || attempt >= retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(time.Duration(attempt+1) * _txRetryBackoff):
+		}
 	}
 }
 
 // execTx executes a callback function within a single transaction
-func (store *SqlStore) execTx(ctx context.Context, fn func(*sqlc.Queries) error) error {
+func (store *SqlStore) execTx(ctx context.Context, opts *sql.TxOptions, fn func(*sql.Tx) error) (err error) {
+	// The queries of the transaction are children of its span so that the time spent in it shows as a whole -.
+	ctx, span := tracing.Tracer().Start(ctx, "db transaction", trace.WithSpanKind(trace.SpanKindClient))
+	defer func() {
+		endQuerySpan(span, err)
+		span.End()
+	}()
+
 	// The options part can be used to set up database isolation level.If nil then default will be
 	//used which is read commited in postgres
-	tx, err := store.db.BeginTx(ctx, nil)
+	tx, err := store.db.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
 
-	// calling the new with a sql transaction not sql.queries object -.
-	q := sqlc.New(tx)
-
-	err = fn(q)
+	err = fn(tx)
 	if err != nil {
 		// If there is an error in rollbacks
 		if rbEr := tx.Rollback(); rbEr != nil {
-			return fmt.Errorf("fn execTx rollback error: %v transaction error: %v", rbEr, err)
+			return fmt.Errorf("fn execTx rollback error: %v transaction error: %w", rbEr, err)
 		}
-		return fmt.Errorf("transaction error: %v", err)
+		return fmt.Errorf("transaction error: %w", err)
 	}
 	return tx.Commit()
 }
+
+// txStore runs the queries on an open transaction, nested WithTx calls use savepoints -.
+type txStore struct {
+	*sqlc.Queries
+	tx    sqlc.DBTX
+	depth int
+}
+
+var _ Store = (*txStore)(nil)
+
+// WithTx runs fn inside a savepoint of the open transaction. The options can not change an open transaction hence are ignored -.
+func (store *txStore) WithTx(ctx context.Context, _ *TxOptions, fn func(q sqlc.Querier) error) error {
+	savepoint := fmt.Sprintf("sp_%d", store.depth+1)
+
+	if _, err := store.tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+		return err
+	}
+
+	err := fn(&txStore{Queries: store.Queries, tx: store.tx, depth: store.depth + 1})
+	if err != nil {
+		if _, rbEr := store.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+savepoint); rbEr != nil {
+			return fmt.Errorf("fn WithTx rollback to savepoint error: %v transaction error: %w", rbEr, err)
+		}
+		return err
+	}
+
+	_, err = store.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+savepoint)
+
+	return err
+}
+
+func isSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == _serializationFailure
+}
@@ -0,0 +1,142 @@
+package intfaces
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func newMockStore(t *testing.T) (Store, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	return NewStore(db), mock
+}
+
+func TestWithTx(t *testing.T) {
+	serializationFailure := &pq.Error{Code: _serializationFailure}
+
+	t.Run("Serialization failures are retried", func(t *testing.T) {
+		store, mock := newMockStore(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit().WillReturnError(serializationFailure)
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		calls := 0
+		err := store.WithTx(context.Background(), nil, func(sqlc.Querier) error {
+			calls++
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Retries give up after MaxRetries", func(t *testing.T) {
+		store, mock := newMockStore(t)
+
+		for i := 0; i < 2; i++ {
+			mock.ExpectBegin()
+			mock.ExpectCommit().WillReturnError(serializationFailure)
+		}
+
+		calls := 0
+		err := store.WithTx(context.Background(), &TxOptions{MaxRetries: 1}, func(sqlc.Querier) error {
+			calls++
+			return nil
+		})
+
+		assert.ErrorIs(t, err, serializationFailure)
+		assert.Equal(t, 2, calls)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoTxRetries runs fn once", func(t *testing.T) {
+		store, mock := newMockStore(t)
+
+		mock.ExpectBegin()
+		mock.ExpectCommit().WillReturnError(serializationFailure)
+
+		calls := 0
+		err := store.WithTx(context.Background(), &TxOptions{MaxRetries: NoTxRetries}, func(sqlc.Querier) error {
+			calls++
+			return nil
+		})
+
+		assert.ErrorIs(t, err, serializationFailure)
+		assert.Equal(t, 1, calls)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Errors of fn roll back without a retry", func(t *testing.T) {
+		store, mock := newMockStore(t)
+		errFn := errors.New("fn failed")
+
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		calls := 0
+		err := store.WithTx(context.Background(), nil, func(sqlc.Querier) error {
+			calls++
+			return errFn
+		})
+
+		assert.ErrorIs(t, err, errFn)
+		assert.Equal(t, 1, calls)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Nested calls use numbered savepoints", func(t *testing.T) {
+		store, mock := newMockStore(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("RELEASE SAVEPOINT sp_2").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("RELEASE SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := store.WithTx(context.Background(), nil, func(q sqlc.Querier) error {
+			return q.(Store).WithTx(context.Background(), nil, func(q sqlc.Querier) error {
+				return q.(Store).WithTx(context.Background(), nil, func(sqlc.Querier) error {
+					return nil
+				})
+			})
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Failed nested calls roll back to their savepoint only", func(t *testing.T) {
+		store, mock := newMockStore(t)
+		errFn := errors.New("fn failed")
+
+		mock.ExpectBegin()
+		mock.ExpectExec("SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("ROLLBACK TO SAVEPOINT sp_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := store.WithTx(context.Background(), nil, func(q sqlc.Querier) error {
+			nestedErr := q.(Store).WithTx(context.Background(), nil, func(sqlc.Querier) error {
+				return errFn
+			})
+
+			assert.ErrorIs(t, nestedErr, errFn)
+
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
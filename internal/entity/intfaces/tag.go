@@ -0,0 +1,21 @@
+package intfaces
+
+import "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+
+// ListBlogsTagMatches are the ways the tags of ListBlogsParams are matched, any is the default -.
+var ListBlogsTagMatches = []string{"any", "all"}
+
+// ListTagsParams pages through the tags the same way ListBlogsParams does in page mode, most used first -.
+type ListTagsParams struct {
+	Page  string `json:"page"`
+	Limit string `json:"limit"`
+	// SkipCount leaves out the COUNT query and hence the totals -.
+	SkipCount bool `json:"skip_count"`
+}
+
+type ListTagsResponse struct {
+	Tags         []sqlc.ListTagsRow `json:"tags"`
+	NextPage     string             `json:"next_page"`
+	PreviousPage string             `json:"previous_page"`
+	PageMeta
+}
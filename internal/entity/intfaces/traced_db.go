@@ -0,0 +1,97 @@
+package intfaces
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/tracing"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"strings"
+)
+
+// _sqlcName prefixes the queries generated by sqlc, the name that follows it names the span -.
+const _sqlcName = "-- name: "
+
+// tracedDB runs every query of the store in a span of its own, the arguments are left out of the spans since they
+// hold the user data -.
+type tracedDB struct {
+	db sqlc.DBTX
+}
+
+var _ sqlc.DBTX = (*tracedDB)(nil)
+
+// ExecContext -.
+func (t *tracedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+
+	res, err := t.db.ExecContext(ctx, query, args...)
+	endQuerySpan(span, err)
+
+	return res, err
+}
+
+// PrepareContext -.
+func (t *tracedDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+
+	stmt, err := t.db.PrepareContext(ctx, query)
+	endQuerySpan(span, err)
+
+	return stmt, err
+}
+
+// QueryContext the span ends once the rows are returned, reading them is left to the span of the caller -.
+func (t *tracedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+
+	rows, err := t.db.QueryContext(ctx, query, args...)
+	endQuerySpan(span, err)
+
+	return rows, err
+}
+
+// QueryRowContext -.
+func (t *tracedDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+
+	row := t.db.QueryRowContext(ctx, query, args...)
+	endQuerySpan(span, row.Err())
+
+	return row
+}
+
+// startQuerySpan names the span after the sqlc query, or the first keyword of the statements written by hand such as
+// the savepoints -.
+func startQuerySpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	operation, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+
+	if name, ok := strings.CutPrefix(query, _sqlcName); ok {
+		operation, _, _ = strings.Cut(name, " ")
+	}
+
+	return tracing.Tracer().Start(ctx, "db "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(query),
+		),
+	)
+}
+
+// endQuerySpan no rows is how a lookup reports a missing row hence is not a failure of the query -.
+func endQuerySpan(span trace.Span, err error) {
+	if err == nil || errors.Is(err, sql.ErrNoRows) {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -5,10 +5,13 @@ package mocks
 import (
 	context "context"
 
+	entity "github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
 	intfaces "github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
 	mock "github.com/stretchr/testify/mock"
 
 	sqlc "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+
+	time "time"
 )
 
 // BlogUsecase is an autogenerated mock type for the BlogUsecase type
@@ -16,25 +19,115 @@ type BlogUsecase struct {
 	mock.Mock
 }
 
-// CreateBlog provides a mock function with given fields: ctx, description
-func (_m *BlogUsecase) CreateBlog(ctx context.Context, description string) (*sqlc.Blog, error) {
-	ret := _m.Called(ctx, description)
+// ArchiveBlog provides a mock function with given fields: ctx, id, precondition
+func (_m *BlogUsecase) ArchiveBlog(ctx context.Context, id string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, id, precondition)
 
 	var r0 *sqlc.Blog
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*sqlc.Blog, error)); ok {
-		return rf(ctx, description)
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Precondition) (*sqlc.Blog, error)); ok {
+		return rf(ctx, id, precondition)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *sqlc.Blog); ok {
-		r0 = rf(ctx, description)
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Precondition) *sqlc.Blog); ok {
+		r0 = rf(ctx, id, precondition)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*sqlc.Blog)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, description)
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Precondition) error); ok {
+		r1 = rf(ctx, id, precondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBlog provides a mock function with given fields: ctx, args
+func (_m *BlogUsecase) CreateBlog(ctx context.Context, args intfaces.CreateBlogParams) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, args)
+
+	var r0 *sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.CreateBlogParams) (*sqlc.Blog, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.CreateBlogParams) *sqlc.Blog); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, intfaces.CreateBlogParams) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBlog provides a mock function with given fields: ctx, id, precondition
+func (_m *BlogUsecase) DeleteBlog(ctx context.Context, id string, precondition entity.Precondition) error {
+	ret := _m.Called(ctx, id, precondition)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Precondition) error); ok {
+		r0 = rf(ctx, id, precondition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiffBlogRevisions provides a mock function with given fields: ctx, id, from, to
+func (_m *BlogUsecase) DiffBlogRevisions(ctx context.Context, id string, from string, to string) (*intfaces.BlogRevisionDiff, error) {
+	ret := _m.Called(ctx, id, from, to)
+
+	var r0 *intfaces.BlogRevisionDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*intfaces.BlogRevisionDiff, error)); ok {
+		return rf(ctx, id, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *intfaces.BlogRevisionDiff); ok {
+		r0 = rf(ctx, id, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.BlogRevisionDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FlushBlogViews provides a mock function with given fields: ctx
+func (_m *BlogUsecase) FlushBlogViews(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -68,6 +161,110 @@ func (_m *BlogUsecase) GetBlog(ctx context.Context, id string) (*sqlc.Blog, erro
 	return r0, r1
 }
 
+// GetBlogBySlug provides a mock function with given fields: ctx, slug
+func (_m *BlogUsecase) GetBlogBySlug(ctx context.Context, slug string) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, slug)
+
+	var r0 *sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*sqlc.Blog, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *sqlc.Blog); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlogRevision provides a mock function with given fields: ctx, id, revision
+func (_m *BlogUsecase) GetBlogRevision(ctx context.Context, id string, revision string) (*sqlc.BlogRevision, error) {
+	ret := _m.Called(ctx, id, revision)
+
+	var r0 *sqlc.BlogRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*sqlc.BlogRevision, error)); ok {
+		return rf(ctx, id, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *sqlc.BlogRevision); ok {
+		r0 = rf(ctx, id, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.BlogRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBlogRevisions provides a mock function with given fields: ctx, id, args
+func (_m *BlogUsecase) ListBlogRevisions(ctx context.Context, id string, args intfaces.ListBlogRevisionsParams) (*intfaces.ListBlogRevisionsResponse, error) {
+	ret := _m.Called(ctx, id, args)
+
+	var r0 *intfaces.ListBlogRevisionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, intfaces.ListBlogRevisionsParams) (*intfaces.ListBlogRevisionsResponse, error)); ok {
+		return rf(ctx, id, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, intfaces.ListBlogRevisionsParams) *intfaces.ListBlogRevisionsResponse); ok {
+		r0 = rf(ctx, id, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.ListBlogRevisionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, intfaces.ListBlogRevisionsParams) error); ok {
+		r1 = rf(ctx, id, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBlogTags provides a mock function with given fields: ctx, id
+func (_m *BlogUsecase) ListBlogTags(ctx context.Context, id string) ([]sqlc.Tag, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []sqlc.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]sqlc.Tag, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []sqlc.Tag); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListBlogs provides a mock function with given fields: ctx, args
 func (_m *BlogUsecase) ListBlogs(ctx context.Context, args intfaces.ListBlogsParams) (*intfaces.ListBlogsResponse, error) {
 	ret := _m.Called(ctx, args)
@@ -94,6 +291,394 @@ func (_m *BlogUsecase) ListBlogs(ctx context.Context, args intfaces.ListBlogsPar
 	return r0, r1
 }
 
+// ListPopularBlogs provides a mock function with given fields: ctx, args
+func (_m *BlogUsecase) ListPopularBlogs(ctx context.Context, args intfaces.ListPopularBlogsParams) (*intfaces.ListPopularBlogsResponse, error) {
+	ret := _m.Called(ctx, args)
+
+	var r0 *intfaces.ListPopularBlogsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.ListPopularBlogsParams) (*intfaces.ListPopularBlogsResponse, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.ListPopularBlogsParams) *intfaces.ListPopularBlogsResponse); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.ListPopularBlogsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, intfaces.ListPopularBlogsParams) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTags provides a mock function with given fields: ctx, args
+func (_m *BlogUsecase) ListTags(ctx context.Context, args intfaces.ListTagsParams) (*intfaces.ListTagsResponse, error) {
+	ret := _m.Called(ctx, args)
+
+	var r0 *intfaces.ListTagsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.ListTagsParams) (*intfaces.ListTagsResponse, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.ListTagsParams) *intfaces.ListTagsResponse); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.ListTagsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, intfaces.ListTagsParams) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTrashedBlogs provides a mock function with given fields: ctx, args
+func (_m *BlogUsecase) ListTrashedBlogs(ctx context.Context, args intfaces.ListTrashedBlogsParams) (*intfaces.ListBlogsResponse, error) {
+	ret := _m.Called(ctx, args)
+
+	var r0 *intfaces.ListBlogsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.ListTrashedBlogsParams) (*intfaces.ListBlogsResponse, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.ListTrashedBlogsParams) *intfaces.ListBlogsResponse); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.ListBlogsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, intfaces.ListTrashedBlogsParams) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PatchBlog provides a mock function with given fields: ctx, id, args, precondition
+func (_m *BlogUsecase) PatchBlog(ctx context.Context, id string, args intfaces.PatchBlogParams, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, id, args, precondition)
+
+	var r0 *sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, intfaces.PatchBlogParams, entity.Precondition) (*sqlc.Blog, error)); ok {
+		return rf(ctx, id, args, precondition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, intfaces.PatchBlogParams, entity.Precondition) *sqlc.Blog); ok {
+		r0 = rf(ctx, id, args, precondition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, intfaces.PatchBlogParams, entity.Precondition) error); ok {
+		r1 = rf(ctx, id, args, precondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishBlog provides a mock function with given fields: ctx, id, publishAt, precondition
+func (_m *BlogUsecase) PublishBlog(ctx context.Context, id string, publishAt *time.Time, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, id, publishAt, precondition)
+
+	var r0 *sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, entity.Precondition) (*sqlc.Blog, error)); ok {
+		return rf(ctx, id, publishAt, precondition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time, entity.Precondition) *sqlc.Blog); ok {
+		r0 = rf(ctx, id, publishAt, precondition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time, entity.Precondition) error); ok {
+		r1 = rf(ctx, id, publishAt, precondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishDueBlogs provides a mock function with given fields: ctx, limit
+func (_m *BlogUsecase) PublishDueBlogs(ctx context.Context, limit int32) ([]sqlc.Blog, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 []sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]sqlc.Blog, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []sqlc.Blog); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeTrashedBlogs provides a mock function with given fields: ctx
+func (_m *BlogUsecase) PurgeTrashedBlogs(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReactToBlog provides a mock function with given fields: ctx, id, kind
+func (_m *BlogUsecase) ReactToBlog(ctx context.Context, id string, kind string) (*intfaces.BlogStats, error) {
+	ret := _m.Called(ctx, id, kind)
+
+	var r0 *intfaces.BlogStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*intfaces.BlogStats, error)); ok {
+		return rf(ctx, id, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *intfaces.BlogStats); ok {
+		r0 = rf(ctx, id, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.BlogStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveBlogReaction provides a mock function with given fields: ctx, id
+func (_m *BlogUsecase) RemoveBlogReaction(ctx context.Context, id string) (*intfaces.BlogStats, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *intfaces.BlogStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*intfaces.BlogStats, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *intfaces.BlogStats); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.BlogStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreBlog provides a mock function with given fields: ctx, id
+func (_m *BlogUsecase) RestoreBlog(ctx context.Context, id string) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*sqlc.Blog, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *sqlc.Blog); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreBlogRevision provides a mock function with given fields: ctx, id, revision, precondition
+func (_m *BlogUsecase) RestoreBlogRevision(ctx context.Context, id string, revision string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, id, revision, precondition)
+
+	var r0 *sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.Precondition) (*sqlc.Blog, error)); ok {
+		return rf(ctx, id, revision, precondition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, entity.Precondition) *sqlc.Blog); ok {
+		r0 = rf(ctx, id, revision, precondition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, entity.Precondition) error); ok {
+		r1 = rf(ctx, id, revision, precondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchBlogs provides a mock function with given fields: ctx, args
+func (_m *BlogUsecase) SearchBlogs(ctx context.Context, args intfaces.SearchBlogsParams) (*intfaces.SearchBlogsResponse, error) {
+	ret := _m.Called(ctx, args)
+
+	var r0 *intfaces.SearchBlogsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.SearchBlogsParams) (*intfaces.SearchBlogsResponse, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, intfaces.SearchBlogsParams) *intfaces.SearchBlogsResponse); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.SearchBlogsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, intfaces.SearchBlogsParams) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnpublishBlog provides a mock function with given fields: ctx, id, precondition
+func (_m *BlogUsecase) UnpublishBlog(ctx context.Context, id string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, id, precondition)
+
+	var r0 *sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Precondition) (*sqlc.Blog, error)); ok {
+		return rf(ctx, id, precondition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Precondition) *sqlc.Blog); ok {
+		r0 = rf(ctx, id, precondition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Precondition) error); ok {
+		r1 = rf(ctx, id, precondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBlog provides a mock function with given fields: ctx, id, args, precondition
+func (_m *BlogUsecase) UpdateBlog(ctx context.Context, id string, args intfaces.UpdateBlogParams, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ret := _m.Called(ctx, id, args, precondition)
+
+	var r0 *sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, intfaces.UpdateBlogParams, entity.Precondition) (*sqlc.Blog, error)); ok {
+		return rf(ctx, id, args, precondition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, intfaces.UpdateBlogParams, entity.Precondition) *sqlc.Blog); ok {
+		r0 = rf(ctx, id, args, precondition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, intfaces.UpdateBlogParams, entity.Precondition) error); ok {
+		r1 = rf(ctx, id, args, precondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewBlog provides a mock function with given fields: ctx, id
+func (_m *BlogUsecase) ViewBlog(ctx context.Context, id string) (*intfaces.BlogView, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *intfaces.BlogView
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*intfaces.BlogView, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *intfaces.BlogView); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*intfaces.BlogView)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewBlogUsecase interface {
 	mock.TestingT
 	Cleanup(func())
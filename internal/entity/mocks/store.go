@@ -5,37 +5,968 @@ package mocks
 import (
 	context "context"
 
+	intfaces "github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
 	mock "github.com/stretchr/testify/mock"
 
-	sql "database/sql"
+	sqlc "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// Store is an autogenerated mock type for the Store type
+type Store struct {
+	mock.Mock
+}
+
+// AddBlogTags provides a mock function with given fields: ctx, arg
+func (_m *Store) AddBlogTags(ctx context.Context, arg sqlc.AddBlogTagsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.AddBlogTagsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddBlogViews provides a mock function with given fields: ctx, arg
+func (_m *Store) AddBlogViews(ctx context.Context, arg sqlc.AddBlogViewsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.AddBlogViewsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompleteIdempotencyKey provides a mock function with given fields: ctx, arg
+func (_m *Store) CompleteIdempotencyKey(ctx context.Context, arg sqlc.CompleteIdempotencyKeyParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CompleteIdempotencyKeyParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CountBlogReactions provides a mock function with given fields: ctx, blogID
+func (_m *Store) CountBlogReactions(ctx context.Context, blogID uuid.UUID) ([]sqlc.CountBlogReactionsRow, error) {
+	ret := _m.Called(ctx, blogID)
+
+	var r0 []sqlc.CountBlogReactionsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.CountBlogReactionsRow, error)); ok {
+		return rf(ctx, blogID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.CountBlogReactionsRow); ok {
+		r0 = rf(ctx, blogID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.CountBlogReactionsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, blogID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountBlogRevisions provides a mock function with given fields: ctx, blogID
+func (_m *Store) CountBlogRevisions(ctx context.Context, blogID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, blogID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, blogID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, blogID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, blogID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountBlogs provides a mock function with given fields: ctx, arg
+func (_m *Store) CountBlogs(ctx context.Context, arg sqlc.CountBlogsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CountBlogsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CountBlogsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CountBlogsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountCommentThread provides a mock function with given fields: ctx, arg
+func (_m *Store) CountCommentThread(ctx context.Context, arg sqlc.CountCommentThreadParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CountCommentThreadParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CountCommentThreadParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CountCommentThreadParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountSearchBlogs provides a mock function with given fields: ctx, arg
+func (_m *Store) CountSearchBlogs(ctx context.Context, arg sqlc.CountSearchBlogsParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CountSearchBlogsParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CountSearchBlogsParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CountSearchBlogsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountTags provides a mock function with given fields: ctx
+func (_m *Store) CountTags(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountTrashedBlogs provides a mock function with given fields: ctx, authorID
+func (_m *Store) CountTrashedBlogs(ctx context.Context, authorID uuid.NullUUID) (int64, error) {
+	ret := _m.Called(ctx, authorID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.NullUUID) (int64, error)); ok {
+		return rf(ctx, authorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.NullUUID) int64); ok {
+		r0 = rf(ctx, authorID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.NullUUID) error); ok {
+		r1 = rf(ctx, authorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBlog provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateBlog(ctx context.Context, arg sqlc.CreateBlogParams) (sqlc.Blog, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateBlogParams) (sqlc.Blog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateBlogParams) sqlc.Blog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Blog)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateBlogParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBlogRevision provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateBlogRevision(ctx context.Context, arg sqlc.CreateBlogRevisionParams) (sqlc.BlogRevision, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.BlogRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateBlogRevisionParams) (sqlc.BlogRevision, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateBlogRevisionParams) sqlc.BlogRevision); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.BlogRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateBlogRevisionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateComment provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateComment(ctx context.Context, arg sqlc.CreateCommentParams) (sqlc.Comment, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateCommentParams) (sqlc.Comment, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateCommentParams) sqlc.Comment); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateCommentParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateIdempotencyKey provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateIdempotencyKey(ctx context.Context, arg sqlc.CreateIdempotencyKeyParams) (sqlc.IdempotencyKey, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateIdempotencyKeyParams) (sqlc.IdempotencyKey, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateIdempotencyKeyParams) sqlc.IdempotencyKey); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.IdempotencyKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRefreshToken provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateRefreshToken(ctx context.Context, arg sqlc.CreateRefreshTokenParams) (sqlc.RefreshToken, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateRefreshTokenParams) (sqlc.RefreshToken, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateRefreshTokenParams) sqlc.RefreshToken); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.RefreshToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateRefreshTokenParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUser provides a mock function with given fields: ctx, arg
+func (_m *Store) CreateUser(ctx context.Context, arg sqlc.CreateUserParams) (sqlc.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateUserParams) (sqlc.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.CreateUserParams) sqlc.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.CreateUserParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBlogReaction provides a mock function with given fields: ctx, arg
+func (_m *Store) DeleteBlogReaction(ctx context.Context, arg sqlc.DeleteBlogReactionParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteBlogReactionParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteBlogReactionParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.DeleteBlogReactionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBlogTags provides a mock function with given fields: ctx, blogID
+func (_m *Store) DeleteBlogTags(ctx context.Context, blogID uuid.UUID) error {
+	ret := _m.Called(ctx, blogID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, blogID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteComment provides a mock function with given fields: ctx, id
+func (_m *Store) DeleteComment(ctx context.Context, id uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteExpiredIdempotencyKeys provides a mock function with given fields: ctx
+func (_m *Store) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteIdempotencyKey provides a mock function with given fields: ctx, arg
+func (_m *Store) DeleteIdempotencyKey(ctx context.Context, arg sqlc.DeleteIdempotencyKeyParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.DeleteIdempotencyKeyParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditComment provides a mock function with given fields: ctx, arg
+func (_m *Store) EditComment(ctx context.Context, arg sqlc.EditCommentParams) (sqlc.Comment, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.EditCommentParams) (sqlc.Comment, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.EditCommentParams) sqlc.Comment); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.EditCommentParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlog provides a mock function with given fields: ctx, id
+func (_m *Store) GetBlog(ctx context.Context, id uuid.UUID) (sqlc.Blog, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.Blog, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.Blog); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.Blog)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlogBySlug provides a mock function with given fields: ctx, slug
+func (_m *Store) GetBlogBySlug(ctx context.Context, slug string) (sqlc.Blog, error) {
+	ret := _m.Called(ctx, slug)
+
+	var r0 sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sqlc.Blog, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sqlc.Blog); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		r0 = ret.Get(0).(sqlc.Blog)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlogReaction provides a mock function with given fields: ctx, arg
+func (_m *Store) GetBlogReaction(ctx context.Context, arg sqlc.GetBlogReactionParams) (sqlc.BlogReaction, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.BlogReaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetBlogReactionParams) (sqlc.BlogReaction, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetBlogReactionParams) sqlc.BlogReaction); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.BlogReaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetBlogReactionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBlogRevision provides a mock function with given fields: ctx, arg
+func (_m *Store) GetBlogRevision(ctx context.Context, arg sqlc.GetBlogRevisionParams) (sqlc.BlogRevision, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.BlogRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetBlogRevisionParams) (sqlc.BlogRevision, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetBlogRevisionParams) sqlc.BlogRevision); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.BlogRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetBlogRevisionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetComment provides a mock function with given fields: ctx, id
+func (_m *Store) GetComment(ctx context.Context, id uuid.UUID) (sqlc.Comment, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 sqlc.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.Comment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.Comment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.Comment)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIdempotencyKey provides a mock function with given fields: ctx, arg
+func (_m *Store) GetIdempotencyKey(ctx context.Context, arg sqlc.GetIdempotencyKeyParams) (sqlc.IdempotencyKey, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetIdempotencyKeyParams) (sqlc.IdempotencyKey, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.GetIdempotencyKeyParams) sqlc.IdempotencyKey); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.IdempotencyKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.GetIdempotencyKeyParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRefreshTokenByHash provides a mock function with given fields: ctx, tokenHash
+func (_m *Store) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (sqlc.RefreshToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	var r0 sqlc.RefreshToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sqlc.RefreshToken, error)); ok {
+		return rf(ctx, tokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sqlc.RefreshToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		r0 = ret.Get(0).(sqlc.RefreshToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *Store) GetUser(ctx context.Context, id uuid.UUID) (sqlc.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (sqlc.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) sqlc.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(sqlc.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByEmail provides a mock function with given fields: ctx, email
+func (_m *Store) GetUserByEmail(ctx context.Context, email string) (sqlc.User, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (sqlc.User, error)); ok {
+		return rf(ctx, email)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) sqlc.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(sqlc.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBlog provides a mock function with given fields: ctx, arg
+func (_m *Store) ListBlog(ctx context.Context, arg sqlc.ListBlogParams) ([]sqlc.Blog, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogParams) ([]sqlc.Blog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogParams) []sqlc.Blog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListBlogParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBlogAfter provides a mock function with given fields: ctx, arg
+func (_m *Store) ListBlogAfter(ctx context.Context, arg sqlc.ListBlogAfterParams) ([]sqlc.Blog, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogAfterParams) ([]sqlc.Blog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogAfterParams) []sqlc.Blog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListBlogAfterParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBlogBefore provides a mock function with given fields: ctx, arg
+func (_m *Store) ListBlogBefore(ctx context.Context, arg sqlc.ListBlogBeforeParams) ([]sqlc.Blog, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogBeforeParams) ([]sqlc.Blog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogBeforeParams) []sqlc.Blog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListBlogBeforeParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBlogRevisions provides a mock function with given fields: ctx, arg
+func (_m *Store) ListBlogRevisions(ctx context.Context, arg sqlc.ListBlogRevisionsParams) ([]sqlc.ListBlogRevisionsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.ListBlogRevisionsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogRevisionsParams) ([]sqlc.ListBlogRevisionsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogRevisionsParams) []sqlc.ListBlogRevisionsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListBlogRevisionsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListBlogRevisionsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBlogTags provides a mock function with given fields: ctx, blogID
+func (_m *Store) ListBlogTags(ctx context.Context, blogID uuid.UUID) ([]sqlc.Tag, error) {
+	ret := _m.Called(ctx, blogID)
+
+	var r0 []sqlc.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]sqlc.Tag, error)); ok {
+		return rf(ctx, blogID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []sqlc.Tag); ok {
+		r0 = rf(ctx, blogID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, blogID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCommentThread provides a mock function with given fields: ctx, arg
+func (_m *Store) ListCommentThread(ctx context.Context, arg sqlc.ListCommentThreadParams) ([]sqlc.Comment, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListCommentThreadParams) ([]sqlc.Comment, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListCommentThreadParams) []sqlc.Comment); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListCommentThreadParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPopularBlogs provides a mock function with given fields: ctx, arg
+func (_m *Store) ListPopularBlogs(ctx context.Context, arg sqlc.ListPopularBlogsParams) ([]sqlc.ListPopularBlogsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.ListPopularBlogsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListPopularBlogsParams) ([]sqlc.ListPopularBlogsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListPopularBlogsParams) []sqlc.ListPopularBlogsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListPopularBlogsRow)
+		}
+	}
 
-	sqlc "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListPopularBlogsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
 
-	uuid "github.com/google/uuid"
-)
+	return r0, r1
+}
 
-// Store is an autogenerated mock type for the Store type
-type Store struct {
-	mock.Mock
+// ListTags provides a mock function with given fields: ctx, arg
+func (_m *Store) ListTags(ctx context.Context, arg sqlc.ListTagsParams) ([]sqlc.ListTagsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.ListTagsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListTagsParams) ([]sqlc.ListTagsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListTagsParams) []sqlc.ListTagsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.ListTagsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListTagsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTrashedBlogs provides a mock function with given fields: ctx, arg
+func (_m *Store) ListTrashedBlogs(ctx context.Context, arg sqlc.ListTrashedBlogsParams) ([]sqlc.Blog, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListTrashedBlogsParams) ([]sqlc.Blog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListTrashedBlogsParams) []sqlc.Blog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Blog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListTrashedBlogsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// CreateBlog provides a mock function with given fields: ctx, descriptions
-func (_m *Store) CreateBlog(ctx context.Context, descriptions sql.NullString) (sqlc.Blog, error) {
-	ret := _m.Called(ctx, descriptions)
+// PatchBlog provides a mock function with given fields: ctx, arg
+func (_m *Store) PatchBlog(ctx context.Context, arg sqlc.PatchBlogParams) (sqlc.Blog, error) {
+	ret := _m.Called(ctx, arg)
 
 	var r0 sqlc.Blog
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) (sqlc.Blog, error)); ok {
-		return rf(ctx, descriptions)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.PatchBlogParams) (sqlc.Blog, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sql.NullString) sqlc.Blog); ok {
-		r0 = rf(ctx, descriptions)
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.PatchBlogParams) sqlc.Blog); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		r0 = ret.Get(0).(sqlc.Blog)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sql.NullString) error); ok {
-		r1 = rf(ctx, descriptions)
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.PatchBlogParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -43,22 +974,58 @@ func (_m *Store) CreateBlog(ctx context.Context, descriptions sql.NullString) (s
 	return r0, r1
 }
 
-// DeleteBlog provides a mock function with given fields: ctx, id
-func (_m *Store) DeleteBlog(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// PublishDueBlogs provides a mock function with given fields: ctx, limit
+func (_m *Store) PublishDueBlogs(ctx context.Context, limit int32) ([]sqlc.Blog, error) {
+	ret := _m.Called(ctx, limit)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	var r0 []sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]sqlc.Blog, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []sqlc.Blog); ok {
+		r0 = rf(ctx, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Blog)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// GetBlog provides a mock function with given fields: ctx, id
-func (_m *Store) GetBlog(ctx context.Context, id uuid.UUID) (sqlc.Blog, error) {
+// PurgeTrashedBlogs provides a mock function with given fields: ctx, deletedBefore
+func (_m *Store) PurgeTrashedBlogs(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreBlog provides a mock function with given fields: ctx, id
+func (_m *Store) RestoreBlog(ctx context.Context, id uuid.UUID) (sqlc.Blog, error) {
 	ret := _m.Called(ctx, id)
 
 	var r0 sqlc.Blog
@@ -81,24 +1048,232 @@ func (_m *Store) GetBlog(ctx context.Context, id uuid.UUID) (sqlc.Blog, error) {
 	return r0, r1
 }
 
-// ListBlog provides a mock function with given fields: ctx, arg
-func (_m *Store) ListBlog(ctx context.Context, arg sqlc.ListBlogParams) ([]sqlc.Blog, error) {
+// RevokeRefreshToken provides a mock function with given fields: ctx, arg
+func (_m *Store) RevokeRefreshToken(ctx context.Context, arg sqlc.RevokeRefreshTokenParams) (int64, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []sqlc.Blog
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogParams) ([]sqlc.Blog, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.RevokeRefreshTokenParams) (int64, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, sqlc.ListBlogParams) []sqlc.Blog); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.RevokeRefreshTokenParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.RevokeRefreshTokenParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeUserRefreshTokens provides a mock function with given fields: ctx, userID
+func (_m *Store) RevokeUserRefreshTokens(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchBlogs provides a mock function with given fields: ctx, arg
+func (_m *Store) SearchBlogs(ctx context.Context, arg sqlc.SearchBlogsParams) ([]sqlc.SearchBlogsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.SearchBlogsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SearchBlogsParams) ([]sqlc.SearchBlogsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.SearchBlogsParams) []sqlc.SearchBlogsRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]sqlc.Blog)
+			r0 = ret.Get(0).([]sqlc.SearchBlogsRow)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, sqlc.ListBlogParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.SearchBlogsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SumBlogViews provides a mock function with given fields: ctx, blogID
+func (_m *Store) SumBlogViews(ctx context.Context, blogID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, blogID)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, blogID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
+		r0 = rf(ctx, blogID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, blogID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrashBlog provides a mock function with given fields: ctx, arg
+func (_m *Store) TrashBlog(ctx context.Context, arg sqlc.TrashBlogParams) (int64, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.TrashBlogParams) (int64, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.TrashBlogParams) int64); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.TrashBlogParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBlog provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateBlog(ctx context.Context, arg sqlc.UpdateBlogParams) (sqlc.Blog, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateBlogParams) (sqlc.Blog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateBlogParams) sqlc.Blog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Blog)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateBlogParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBlogStatus provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateBlogStatus(ctx context.Context, arg sqlc.UpdateBlogStatusParams) (sqlc.Blog, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.Blog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateBlogStatusParams) (sqlc.Blog, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateBlogStatusParams) sqlc.Blog); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.Blog)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateBlogStatusParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUserRole provides a mock function with given fields: ctx, arg
+func (_m *Store) UpdateUserRole(ctx context.Context, arg sqlc.UpdateUserRoleParams) (sqlc.User, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateUserRoleParams) (sqlc.User, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpdateUserRoleParams) sqlc.User); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpdateUserRoleParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertBlogReaction provides a mock function with given fields: ctx, arg
+func (_m *Store) UpsertBlogReaction(ctx context.Context, arg sqlc.UpsertBlogReactionParams) (sqlc.BlogReaction, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 sqlc.BlogReaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertBlogReactionParams) (sqlc.BlogReaction, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertBlogReactionParams) sqlc.BlogReaction); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(sqlc.BlogReaction)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpsertBlogReactionParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertTags provides a mock function with given fields: ctx, arg
+func (_m *Store) UpsertTags(ctx context.Context, arg sqlc.UpsertTagsParams) ([]sqlc.Tag, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []sqlc.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertTagsParams) ([]sqlc.Tag, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, sqlc.UpsertTagsParams) []sqlc.Tag); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sqlc.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, sqlc.UpsertTagsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -107,6 +1282,20 @@ func (_m *Store) ListBlog(ctx context.Context, arg sqlc.ListBlogParams) ([]sqlc.
 	return r0, r1
 }
 
+// WithTx provides a mock function with given fields: ctx, opts, fn
+func (_m *Store) WithTx(ctx context.Context, opts *intfaces.TxOptions, fn func(sqlc.Querier) error) error {
+	ret := _m.Called(ctx, opts, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *intfaces.TxOptions, func(sqlc.Querier) error) error); ok {
+		r0 = rf(ctx, opts, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewStore interface {
 	mock.TestingT
 	Cleanup(func())
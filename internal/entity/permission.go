@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"context"
+	"github.com/google/uuid"
+)
+
+// Permission is an action a role may perform -.
+type Permission string
+
+const (
+	PermissionBlogRead   Permission = "blog:read"
+	PermissionBlogCreate Permission = "blog:create"
+	PermissionBlogUpdate Permission = "blog:update"
+	PermissionBlogDelete Permission = "blog:delete"
+	// PermissionBlogPublish moves a blog through the publish lifecycle -.
+	PermissionBlogPublish Permission = "blog:publish"
+	// PermissionBlogTrash lists and restores the trashed blogs of every author -.
+	PermissionBlogTrash Permission = "blog:trash"
+	// PermissionBlogReact likes or otherwise reacts to a blog -.
+	PermissionBlogReact Permission = "blog:react"
+
+	PermissionCommentRead   Permission = "comment:read"
+	PermissionCommentCreate Permission = "comment:create"
+	// PermissionCommentUpdate and PermissionCommentDelete only apply to the comments of the caller -.
+	PermissionCommentUpdate Permission = "comment:update"
+	PermissionCommentDelete Permission = "comment:delete"
+	// PermissionCommentModerate deletes the comments of anyone -.
+	PermissionCommentModerate Permission = "comment:moderate"
+
+	// PermissionUserRole changes the role of another user -.
+	PermissionUserRole Permission = "user:role"
+)
+
+// rolePermissions is the policy of what each of the user_roles may do -.
+var rolePermissions = map[Role][]Permission{
+	RoleAuthor: {PermissionBlogRead, PermissionBlogCreate, PermissionBlogUpdate, PermissionBlogDelete, PermissionBlogPublish,
+		PermissionBlogReact, PermissionCommentRead, PermissionCommentCreate, PermissionCommentUpdate, PermissionCommentDelete},
+	RoleReader: {PermissionBlogRead, PermissionBlogReact, PermissionCommentRead, PermissionCommentCreate, PermissionCommentUpdate, PermissionCommentDelete},
+	RoleAdmin:  {PermissionBlogRead, PermissionBlogTrash, PermissionCommentRead, PermissionCommentModerate, PermissionUserRole},
+}
+
+// HasRole reports whether the identity has any of the roles -.
+func (i *Identity) HasRole(roles ...Role) bool {
+	for _, role := range roles {
+		if i.Role == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Can reports whether the role of the identity grants the permission -.
+func (i *Identity) Can(permission Permission) bool {
+	for _, p := range rolePermissions[i.Role] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Authorize returns the caller identity if it holds the permission.
+// Anonymous callers get ErrUnauthorized and callers lacking the permission get ErrForbidden -.
+func Authorize(ctx context.Context, permission Permission) (*Identity, error) {
+	identity, ok := IdentityFromContext(ctx)
+
+	if !ok {
+		return nil, ErrUnauthorized.WithMessage("authentication is required")
+	}
+
+	if !identity.Can(permission) {
+		return nil, ErrForbidden.WithMessage("the " + string(identity.Role) + " role is not allowed to " + string(permission))
+	}
+
+	return identity, nil
+}
+
+// AuthorizeOwner is Authorize for resources that can only be changed by their owner -.
+func AuthorizeOwner(ctx context.Context, permission Permission, ownerID uuid.NullUUID) (*Identity, error) {
+	identity, err := Authorize(ctx, permission)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !ownerID.Valid || ownerID.UUID != identity.UserID {
+		return nil, ErrForbidden.WithMessage("only the owner is allowed to " + string(permission))
+	}
+
+	return identity, nil
+}
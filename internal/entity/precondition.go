@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+)
+
+// ETag is the strong entity tag of a resource at a version, it changes with every change of the resource -.
+func ETag(id uuid.UUID, version int32) string {
+	return fmt.Sprintf(`"%s-%d"`, id, version)
+}
+
+// Precondition is the state the client expects a resource to be in, sent as a list of entity tags in the If-Match or
+// If-None-Match header -.
+type Precondition struct {
+	ETags []string
+	// Any is set by *, it matches any version of the resource -.
+	Any bool
+	// Sent is set when the header was sent. An If-Match of weak tags only is sent although none of its tags can match -.
+	Sent bool
+}
+
+// ParsePrecondition parses the entity tags of an If-Match or If-None-Match header. Weak tags are only kept when weak is
+// set, If-Match compares the tags strongly so a weak tag never matches there -.
+func ParsePrecondition(header string, weak bool) Precondition {
+	precondition := Precondition{Sent: strings.TrimSpace(header) != ""}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+
+		switch {
+		case tag == "*":
+			precondition.Any = true
+		case strings.HasPrefix(tag, "W/"):
+			if weak {
+				precondition.ETags = append(precondition.ETags, strings.TrimPrefix(tag, "W/"))
+			}
+		case tag != "":
+			precondition.ETags = append(precondition.ETags, tag)
+		}
+	}
+
+	return precondition
+}
+
+// Empty reports whether the client sent no precondition -.
+func (p Precondition) Empty() bool {
+	return !p.Sent && !p.Any && len(p.ETags) == 0
+}
+
+// Matches reports whether the resource tagged etag is in the expected state -.
+func (p Precondition) Matches(etag string) bool {
+	if p.Any {
+		return true
+	}
+
+	for _, tag := range p.ETags {
+		if tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check guards a change of the resource tagged etag. A change without a precondition is refused so that a client can
+// not overwrite changes it has not seen -.
+func (p Precondition) Check(etag string) error {
+	if p.Empty() {
+		return ErrPreconditionMissing.WithMessage("send the ETag of the resource in the If-Match header")
+	}
+
+	if !p.Matches(etag) {
+		return ErrPreconditionFailed.WithMessage("the resource was changed since it was read, fetch it again").
+			WithDetails(map[string]interface{}{"etag": etag})
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPreconditionCheck(t *testing.T) {
+	etag := ETag(uuid.New(), 2)
+
+	for _, tc := range []struct {
+		name   string
+		header string
+		err    error
+	}{
+		{"Matching tag", `"stale", ` + etag, nil},
+		{"Any version", "*", nil},
+		{"Stale tag", `"stale"`, ErrPreconditionFailed},
+		{"Weak tags never match", "W/" + etag, ErrPreconditionFailed},
+		{"No header", "", ErrPreconditionMissing},
+		{"Blank header", "  ", ErrPreconditionMissing},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ParsePrecondition(tc.header, false).Check(etag)
+
+			if tc.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package auth_usecase
+
+import (
+	"github.com/harmannkibue/golang_gin_clean_architecture/config"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"time"
+)
+
+type AuthUseCase struct {
+	config *config.Config
+	store  intfaces.Store
+	// now is swapped in tests to control token expiry -.
+	now func() time.Time
+}
+
+func NewAuthUseCase(store intfaces.Store, config *config.Config) intfaces.IntAuthUsecase {
+	return &AuthUseCase{
+		store:  store,
+		config: config,
+		now:    time.Now,
+	}
+}
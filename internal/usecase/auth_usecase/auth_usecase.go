@@ -0,0 +1,254 @@
+package auth_usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/lib/pq"
+	"golang.org/x/crypto/bcrypt"
+	"net/mail"
+	"strings"
+)
+
+const (
+	_minPasswordLength = 8
+	// Postgres SQLSTATE returned when a unique constraint is violated -.
+	_uniqueViolation = "23505"
+	// _dummyPasswordHash is compared against when the email is unknown so that a login takes as long whether the
+	// account exists or not, it is a bcrypt hash at bcrypt.DefaultCost of a throwaway password -.
+	_dummyPasswordHash = "$2a$10$fFS34E6ra.sXNDOXrUITdeAIn7HWiBt19A5pvXtS17faZhOuibioK"
+)
+
+var (
+	errInvalidCredentials  = entity.ErrUnauthorized.WithMessage("invalid email or password")
+	errInvalidRefreshToken = entity.ErrUnauthorized.WithMessage("invalid refresh token")
+)
+
+// Register creates a new reader with a bcrypt hashed password, the author role is granted by an admin -.
+func (usecase *AuthUseCase) Register(ctx context.Context, args intfaces.RegisterParams) (*intfaces.UserResponse, error) {
+	email := strings.ToLower(strings.TrimSpace(args.Email))
+
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, entity.ErrBadRequest.WithMessage("enter a valid email address").Wrap(err)
+	}
+
+	if len(args.Password) < _minPasswordLength {
+		return nil, entity.ErrBadRequest.WithMessage(fmt.Sprintf("the password must be at least %d characters", _minPasswordLength))
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(args.Password), bcrypt.DefaultCost)
+
+	if err != nil {
+		// bcrypt rejects passwords longer than 72 bytes -.
+		return nil, entity.ErrBadRequest.WithMessage("enter a valid password").Wrap(err)
+	}
+
+	user, err := usecase.store.CreateUser(ctx, sqlc.CreateUserParams{
+		Email:        email,
+		PasswordHash: string(hash),
+		UserRole:     sqlc.UserRolesReader,
+	})
+
+	if err != nil {
+		var pqErr *pq.Error
+
+		if errors.As(err, &pqErr) && pqErr.Code == _uniqueViolation {
+			return nil, entity.ErrConflict.WithMessage("the email is already registered").Wrap(err)
+		}
+
+		return nil, authStoreError("uc.usecase.Register", err)
+	}
+
+	return userResponse(user), nil
+}
+
+// Login verifies the credentials and starts a new session -.
+func (usecase *AuthUseCase) Login(ctx context.Context, email string, password string) (*intfaces.AuthTokens, error) {
+	user, err := usecase.store.GetUserByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			_ = bcrypt.CompareHashAndPassword([]byte(_dummyPasswordHash), []byte(password))
+
+			return nil, errInvalidCredentials
+		}
+
+		return nil, authStoreError("uc.usecase.Login", err)
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, errInvalidCredentials
+	}
+
+	refreshToken, _, err := usecase.createRefreshToken(ctx, usecase.store, user.ID)
+
+	if err != nil {
+		return nil, authStoreError("uc.usecase.Login", err)
+	}
+
+	return usecase.authTokens(user, refreshToken)
+}
+
+// Refresh rotates the refresh token, each refresh token can only be used once -.
+func (usecase *AuthUseCase) Refresh(ctx context.Context, refreshToken string) (*intfaces.AuthTokens, error) {
+	token, err := usecase.store.GetRefreshTokenByHash(ctx, hashRefreshToken(refreshToken))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errInvalidRefreshToken
+		}
+
+		return nil, authStoreError("uc.usecase.Refresh", err)
+	}
+
+	if token.RevokedAt.Valid {
+		// Only a rotated token has a replacement, replaying one means it has leaked hence all the sessions of the
+		// user are revoked. Tokens revoked by a logout are just rejected -.
+		if token.ReplacedBy.Valid {
+			if err = usecase.store.RevokeUserRefreshTokens(ctx, token.UserID); err != nil {
+				return nil, authStoreError("uc.usecase.Refresh", err)
+			}
+		}
+
+		return nil, errInvalidRefreshToken
+	}
+
+	if !usecase.now().Before(token.ExpiresAt) {
+		return nil, errInvalidRefreshToken
+	}
+
+	user, err := usecase.store.GetUser(ctx, token.UserID)
+
+	if err != nil {
+		return nil, authStoreError("uc.usecase.Refresh", err)
+	}
+
+	var tokens *intfaces.AuthTokens
+
+	err = usecase.store.WithTx(ctx, nil, func(q sqlc.Querier) error {
+		newToken, newTokenRow, err := usecase.createRefreshToken(ctx, q, user.ID)
+
+		if err != nil {
+			return err
+		}
+
+		revoked, err := q.RevokeRefreshToken(ctx, sqlc.RevokeRefreshTokenParams{
+			ID:         token.ID,
+			ReplacedBy: uuid.NullUUID{UUID: newTokenRow.ID, Valid: true},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		// Another request rotated the same token first -.
+		if revoked == 0 {
+			return errInvalidRefreshToken
+		}
+
+		tokens, err = usecase.authTokens(user, newToken)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, authStoreError("uc.usecase.Refresh", err)
+	}
+
+	return tokens, nil
+}
+
+// Logout revokes the refresh token without a replacement, unknown or already revoked tokens are ignored -.
+func (usecase *AuthUseCase) Logout(ctx context.Context, refreshToken string) error {
+	token, err := usecase.store.GetRefreshTokenByHash(ctx, hashRefreshToken(refreshToken))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return authStoreError("uc.usecase.Logout", err)
+	}
+
+	if _, err = usecase.store.RevokeRefreshToken(ctx, sqlc.RevokeRefreshTokenParams{ID: token.ID}); err != nil {
+		return authStoreError("uc.usecase.Logout", err)
+	}
+
+	return nil
+}
+
+// SetUserRole grants the author or reader role to a user, it takes effect on the next refresh of their session -.
+func (usecase *AuthUseCase) SetUserRole(ctx context.Context, id string, role sqlc.UserRoles) (*intfaces.UserResponse, error) {
+	if _, err := entity.Authorize(ctx, entity.PermissionUserRole); err != nil {
+		return nil, err
+	}
+
+	uuID, err := uuid.Parse(id)
+
+	if err != nil {
+		return nil, entity.ErrBadRequest.WithMessage("invalid user id").Wrap(err)
+	}
+
+	if !grantableRole(role) {
+		return nil, entity.ErrBadRequest.WithMessage("enter a valid user role")
+	}
+
+	user, err := usecase.store.UpdateUserRole(ctx, sqlc.UpdateUserRoleParams{UserRole: role, ID: uuID})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrNotFound.WithMessage("user not found")
+		}
+
+		return nil, authStoreError("uc.usecase.SetUserRole", err)
+	}
+
+	return userResponse(user), nil
+}
+
+// Authenticate verifies the access token signature, issuer and expiry -.
+func (usecase *AuthUseCase) Authenticate(ctx context.Context, accessToken string) (*entity.Identity, error) {
+	claims, err := usecase.parseAccessToken(accessToken)
+
+	if err != nil {
+		return nil, entity.ErrUnauthorized.WithMessage("invalid access token").Wrap(err)
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+
+	if err != nil {
+		return nil, entity.ErrUnauthorized.WithMessage("invalid access token").Wrap(err)
+	}
+
+	return &entity.Identity{UserID: userID, Email: claims.Email, Role: claims.Role}, nil
+}
+
+// grantableRole reports whether the role can be granted through the API, admins are only made in the database -.
+func grantableRole(role sqlc.UserRoles) bool {
+	return role == sqlc.UserRolesAuthor || role == sqlc.UserRolesReader
+}
+
+func userResponse(user sqlc.User) *intfaces.UserResponse {
+	return &intfaces.UserResponse{
+		ID:        user.ID,
+		Email:     user.Email,
+		Role:      user.UserRole,
+		CreatedAt: user.CreatedAt,
+	}
+}
+
+// authStoreError keeps the entity errors raised inside transactions and treats the rest as internal errors -.
+func authStoreError(op string, err error) error {
+	var appErr *entity.AppError
+
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	return entity.ErrInternalServerError.Wrap(fmt.Errorf("IntAuthUsecase - %s: %w", op, err))
+}
@@ -0,0 +1,230 @@
+package auth_usecase
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/config"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/mocks"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+	"testing"
+	"time"
+)
+
+func testConfig() *config.Config {
+	return &config.Config{Auth: config.Auth{
+		AccessTokenSecret: "secret",
+		AccessTokenTTL:    15 * time.Minute,
+		RefreshTokenTTL:   time.Hour,
+		Issuer:            "test",
+	}}
+}
+
+func TestMockLogin(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	user := sqlc.User{ID: uuid.New(), Email: "jane@example.com", PasswordHash: string(hash), UserRole: sqlc.UserRolesAuthor}
+
+	t.Run("success", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := context.Background()
+		mockStore.On("GetUserByEmail", ctx, user.Email).Return(user, nil).Once()
+		mockStore.On("CreateRefreshToken", ctx, mock.AnythingOfType("sqlc.CreateRefreshTokenParams")).
+			Return(sqlc.RefreshToken{ID: uuid.New(), UserID: user.ID}, nil).Once()
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		tokens, err := authUsecase.Login(ctx, " Jane@example.com", "password123")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, tokens.RefreshToken)
+
+		identity, err := authUsecase.Authenticate(ctx, tokens.AccessToken)
+
+		assert.NoError(t, err)
+		assert.Equal(t, user.ID, identity.UserID)
+		assert.Equal(t, entity.RoleAuthor, identity.Role)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := context.Background()
+		mockStore.On("GetUserByEmail", ctx, user.Email).Return(user, nil).Once()
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		_, err := authUsecase.Login(ctx, user.Email, "wrong-password")
+
+		assert.ErrorIs(t, err, entity.ErrUnauthorized)
+		mockStore.AssertNotCalled(t, "CreateRefreshToken")
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := context.Background()
+		mockStore.On("GetUserByEmail", ctx, "nobody@example.com").Return(sqlc.User{}, sql.ErrNoRows).Once()
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		_, err := authUsecase.Login(ctx, "nobody@example.com", "password123")
+
+		assert.Equal(t, errInvalidCredentials, err)
+		// The dummy hash costs as much as the hashes of the users so that the accounts can not be told apart by timing -.
+		cost, err := bcrypt.Cost([]byte(_dummyPasswordHash))
+		assert.NoError(t, err)
+		assert.Equal(t, bcrypt.DefaultCost, cost)
+	})
+}
+
+func TestMockAuthenticateExpiredToken(t *testing.T) {
+	authUsecase := NewAuthUseCase(new(mocks.Store), testConfig()).(*AuthUseCase)
+
+	tokens, err := authUsecase.authTokens(sqlc.User{ID: uuid.New()}, "")
+	assert.NoError(t, err)
+
+	authUsecase.now = func() time.Time { return time.Now().Add(time.Hour) }
+
+	_, err = authUsecase.Authenticate(context.Background(), tokens.AccessToken)
+
+	assert.ErrorIs(t, err, entity.ErrUnauthorized)
+}
+
+func TestMockRefresh(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("rotates the token", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := context.Background()
+		current := sqlc.RefreshToken{ID: uuid.New(), UserID: userID, ExpiresAt: time.Now().Add(time.Hour)}
+		rotated := sqlc.RefreshToken{ID: uuid.New(), UserID: userID}
+
+		mockStore.On("GetRefreshTokenByHash", ctx, hashRefreshToken("current")).Return(current, nil).Once()
+		mockStore.On("GetUser", ctx, userID).Return(sqlc.User{ID: userID}, nil).Once()
+		mockStore.On("WithTx", ctx, (*intfaces.TxOptions)(nil), mock.Anything).
+			Return(func(ctx context.Context, _ *intfaces.TxOptions, fn func(sqlc.Querier) error) error {
+				return fn(mockStore)
+			}).Once()
+		mockStore.On("CreateRefreshToken", ctx, mock.AnythingOfType("sqlc.CreateRefreshTokenParams")).Return(rotated, nil).Once()
+		mockStore.On("RevokeRefreshToken", ctx, sqlc.RevokeRefreshTokenParams{
+			ID:         current.ID,
+			ReplacedBy: uuid.NullUUID{UUID: rotated.ID, Valid: true},
+		}).Return(int64(1), nil).Once()
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		tokens, err := authUsecase.Refresh(ctx, "current")
+
+		assert.NoError(t, err)
+		assert.NotEqual(t, "current", tokens.RefreshToken)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("reused token revokes all sessions", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := context.Background()
+		revoked := sqlc.RefreshToken{
+			ID:         uuid.New(),
+			UserID:     userID,
+			ExpiresAt:  time.Now().Add(time.Hour),
+			RevokedAt:  sql.NullTime{Time: time.Now(), Valid: true},
+			ReplacedBy: uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		}
+
+		mockStore.On("GetRefreshTokenByHash", ctx, hashRefreshToken("leaked")).Return(revoked, nil).Once()
+		mockStore.On("RevokeUserRefreshTokens", ctx, userID).Return(nil).Once()
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		_, err := authUsecase.Refresh(ctx, "leaked")
+
+		assert.ErrorIs(t, err, entity.ErrUnauthorized)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("logged out token is rejected without revoking the other sessions", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := context.Background()
+		loggedOut := sqlc.RefreshToken{
+			ID:        uuid.New(),
+			UserID:    userID,
+			ExpiresAt: time.Now().Add(time.Hour),
+			RevokedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}
+
+		mockStore.On("GetRefreshTokenByHash", ctx, hashRefreshToken("logged-out")).Return(loggedOut, nil).Once()
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		_, err := authUsecase.Refresh(ctx, "logged-out")
+
+		assert.ErrorIs(t, err, entity.ErrUnauthorized)
+		mockStore.AssertNotCalled(t, "RevokeUserRefreshTokens", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMockRegister(t *testing.T) {
+	mockStore := new(mocks.Store)
+	ctx := context.Background()
+	mockStore.On("CreateUser", ctx, mock.MatchedBy(func(args sqlc.CreateUserParams) bool {
+		return args.Email == "jane@example.com" && args.UserRole == sqlc.UserRolesReader
+	})).Return(sqlc.User{ID: uuid.New(), Email: "jane@example.com", UserRole: sqlc.UserRolesReader}, nil).Once()
+	authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+	user, err := authUsecase.Register(ctx, intfaces.RegisterParams{Email: "Jane@example.com", Password: "password123"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, sqlc.UserRolesReader, user.Role)
+	mockStore.AssertExpectations(t)
+}
+
+func TestMockSetUserRole(t *testing.T) {
+	userID := uuid.New()
+	admin := &entity.Identity{UserID: uuid.New(), Role: entity.RoleAdmin}
+
+	t.Run("admins grant the author role", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), admin)
+		mockStore.On("UpdateUserRole", ctx, sqlc.UpdateUserRoleParams{UserRole: sqlc.UserRolesAuthor, ID: userID}).
+			Return(sqlc.User{ID: userID, UserRole: sqlc.UserRolesAuthor}, nil).Once()
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		user, err := authUsecase.SetUserRole(ctx, userID.String(), sqlc.UserRolesAuthor)
+
+		assert.NoError(t, err)
+		assert.Equal(t, sqlc.UserRolesAuthor, user.Role)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("admins are not granted through the API", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), admin)
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		_, err := authUsecase.SetUserRole(ctx, userID.String(), sqlc.UserRolesAdmin)
+
+		assert.ErrorIs(t, err, entity.ErrBadRequest)
+		mockStore.AssertNotCalled(t, "UpdateUserRole", mock.Anything, mock.Anything)
+	})
+
+	t.Run("authors can not promote themselves", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), &entity.Identity{UserID: userID, Role: entity.RoleAuthor})
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		_, err := authUsecase.SetUserRole(ctx, userID.String(), sqlc.UserRolesAuthor)
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+		mockStore.AssertNotCalled(t, "UpdateUserRole", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), admin)
+		mockStore.On("UpdateUserRole", ctx, mock.Anything).Return(sqlc.User{}, sql.ErrNoRows).Once()
+		authUsecase := NewAuthUseCase(mockStore, testConfig())
+
+		_, err := authUsecase.SetUserRole(ctx, userID.String(), sqlc.UserRolesReader)
+
+		assert.ErrorIs(t, err, entity.ErrNotFound)
+		mockStore.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,105 @@
+package auth_usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+)
+
+const (
+	_refreshTokenBytes = 32
+	_tokenType         = "Bearer"
+)
+
+// accessTokenClaims are the claims of the signed access token, the subject is the user id -.
+type accessTokenClaims struct {
+	Email string      `json:"email"`
+	Role  entity.Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// authTokens signs a new access token for the user and pairs it with the refresh token -.
+func (usecase *AuthUseCase) authTokens(user sqlc.User, refreshToken string) (*intfaces.AuthTokens, error) {
+	now := usecase.now()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, accessTokenClaims{
+		Email: user.Email,
+		Role:  entity.Role(user.UserRole),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   user.ID.String(),
+			Issuer:    usecase.config.Auth.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(usecase.config.Auth.AccessTokenTTL)),
+		},
+	})
+
+	accessToken, err := token.SignedString([]byte(usecase.config.Auth.AccessTokenSecret))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &intfaces.AuthTokens{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    _tokenType,
+		ExpiresIn:    int64(usecase.config.Auth.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func (usecase *AuthUseCase) parseAccessToken(accessToken string) (*accessTokenClaims, error) {
+	claims := &accessTokenClaims{}
+
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(*jwt.Token) (interface{}, error) {
+		return []byte(usecase.config.Auth.AccessTokenSecret), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(usecase.config.Auth.Issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(usecase.now),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// createRefreshToken generates a random refresh token, only its hash is stored -.
+func (usecase *AuthUseCase) createRefreshToken(ctx context.Context, q sqlc.Querier, userID uuid.UUID) (string, sqlc.RefreshToken, error) {
+	b := make([]byte, _refreshTokenBytes)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", sqlc.RefreshToken{}, err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	row, err := q.CreateRefreshToken(ctx, sqlc.CreateRefreshTokenParams{
+		UserID:    userID,
+		TokenHash: hashRefreshToken(token),
+		ExpiresAt: usecase.now().Add(usecase.config.Auth.RefreshTokenTTL),
+	})
+
+	if err != nil {
+		return "", sqlc.RefreshToken{}, err
+	}
+
+	return token, row, nil
+}
+
+// hashRefreshToken refresh tokens are high entropy random values hence a plain sha256 is enough -.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
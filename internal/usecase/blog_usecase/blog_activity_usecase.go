@@ -0,0 +1,269 @@
+package blog_usecase
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+)
+
+// _reactionWeight is how many views a reaction counts as on the popular listing -.
+const _reactionWeight = 5
+
+// viewBuffer counts the views of the blogs between two flushes. Every instance flushes its own counts as an
+// increment of a counter row hence no view is lost or counted twice however many instances serve the blogs -.
+type viewBuffer struct {
+	mu     sync.Mutex
+	counts map[uuid.UUID]int64
+}
+
+func (b *viewBuffer) add(blogID uuid.UUID, views int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.counts == nil {
+		b.counts = make(map[uuid.UUID]int64)
+	}
+
+	b.counts[blogID] += views
+}
+
+func (b *viewBuffer) pending(blogID uuid.UUID) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.counts[blogID]
+}
+
+// drain takes the counts out of the buffer, the views counted meanwhile go into the next flush -.
+func (b *viewBuffer) drain() map[uuid.UUID]int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	counts := b.counts
+	b.counts = nil
+
+	return counts
+}
+
+// ViewBlog fetches a blog and counts a view when it is published -.
+func (usecase *BlogUseCase) ViewBlog(ctx context.Context, id string) (*intfaces.BlogView, error) {
+	blog, err := usecase.GetBlog(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	identity, _ := entity.IdentityFromContext(ctx)
+
+	stats, err := usecase.blogStats(ctx, blog.ID, identity)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The view is counted once the stats are read so that they are those the reader had before this fetch -.
+	if blog.Status == sqlc.BlogStatusPublished {
+		usecase.views.add(blog.ID, 1)
+	}
+
+	return &intfaces.BlogView{Blog: *blog, Stats: *stats}, nil
+}
+
+// ReactToBlog sets the reaction of the caller on a published blog, every user has at most one reaction per blog -.
+func (usecase *BlogUseCase) ReactToBlog(ctx context.Context, id string, kind string) (*intfaces.BlogStats, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionBlogReact)
+
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := sqlc.ReactionKind(kind)
+
+	if !slices.Contains(sqlc.AllReactionKindValues(), reaction) {
+		return nil, entity.ErrBadRequest.WithMessage("unknown reaction " + kind).
+			WithDetails(map[string]interface{}{"allowed": sqlc.AllReactionKindValues()})
+	}
+
+	blog, err := usecase.reactedBlog(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = usecase.store.UpsertBlogReaction(ctx, sqlc.UpsertBlogReactionParams{BlogID: blog.ID, UserID: identity.UserID, Kind: reaction})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ReactToBlog", err)
+	}
+
+	return usecase.blogStats(ctx, blog.ID, identity)
+}
+
+// RemoveBlogReaction takes back the reaction of the caller, removing a reaction that is not there is not an error -.
+func (usecase *BlogUseCase) RemoveBlogReaction(ctx context.Context, id string) (*intfaces.BlogStats, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionBlogReact)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.reactedBlog(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = usecase.store.DeleteBlogReaction(ctx, sqlc.DeleteBlogReactionParams{BlogID: blog.ID, UserID: identity.UserID})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.RemoveBlogReaction", err)
+	}
+
+	return usecase.blogStats(ctx, blog.ID, identity)
+}
+
+// ListPopularBlogs lists the published blogs by a score that halves with every half life since each view and reaction -.
+func (usecase *BlogUseCase) ListPopularBlogs(ctx context.Context, args intfaces.ListPopularBlogsParams) (*intfaces.ListPopularBlogsResponse, error) {
+	if _, err := entity.Authorize(ctx, entity.PermissionBlogRead); err != nil {
+		return nil, err
+	}
+
+	paginator, err := utils.ParsePaginator(args.Page, args.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	Limit, Offset := paginator.Params()
+
+	blogs, err := usecase.store.ListPopularBlogs(ctx, sqlc.ListPopularBlogsParams{
+		HalfLifeSeconds: usecase.config.Activity.PopularHalfLife.Seconds(),
+		Since:           time.Now().Add(-usecase.config.Activity.PopularWindow),
+		ReactionWeight:  _reactionWeight,
+		Limit:           Limit,
+		Offset:          Offset,
+	})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListPopularBlogs", err)
+	}
+
+	blogs, nextPage, previousPage, meta := utils.PaginatorPage(ctx, paginator, blogs)
+
+	return &intfaces.ListPopularBlogsResponse{
+		Blogs:        blogs,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
+		PageMeta:     meta,
+	}, nil
+}
+
+// FlushBlogViews adds the buffered views to a random shard of the counters of the current hour. The counts are put
+// back into the buffer when the write fails so that they go out with the next flush -.
+func (usecase *BlogUseCase) FlushBlogViews(ctx context.Context) (int64, error) {
+	counts := usecase.views.drain()
+
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	params := sqlc.AddBlogViewsParams{
+		Bucket:  time.Now().Truncate(time.Hour),
+		BlogIds: make([]uuid.UUID, 0, len(counts)),
+		Views:   make([]int64, 0, len(counts)),
+	}
+
+	if shards := usecase.config.Activity.ViewShards; shards > 1 {
+		params.Shard = rand.N(shards)
+	}
+
+	for blogID := range counts {
+		params.BlogIds = append(params.BlogIds, blogID)
+	}
+
+	// The counter rows are locked in the order of the blogs, two instances flushing to the same shard would deadlock
+	// on the rows they both hold if each locked them in the order of its map -.
+	slices.SortFunc(params.BlogIds, func(a, b uuid.UUID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+
+	var flushed int64
+
+	for _, blogID := range params.BlogIds {
+		params.Views = append(params.Views, counts[blogID])
+		flushed += counts[blogID]
+	}
+
+	if err := usecase.store.AddBlogViews(ctx, params); err != nil {
+		for blogID, views := range counts {
+			usecase.views.add(blogID, views)
+		}
+
+		return 0, blogStoreError("uc.usecase.FlushBlogViews", err)
+	}
+
+	return flushed, nil
+}
+
+// reactedBlog fetches the blog a reaction is about, only published blogs can be reacted to -.
+func (usecase *BlogUseCase) reactedBlog(ctx context.Context, id string) (*sqlc.Blog, error) {
+	blog, err := usecase.GetBlog(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if blog.Status != sqlc.BlogStatusPublished {
+		return nil, entity.ErrConflict.WithMessage("only published blogs can be reacted to")
+	}
+
+	return blog, nil
+}
+
+// blogStats sums the views of a blog and counts its reactions by kind -.
+func (usecase *BlogUseCase) blogStats(ctx context.Context, blogID uuid.UUID, identity *entity.Identity) (*intfaces.BlogStats, error) {
+	views, err := usecase.store.SumBlogViews(ctx, blogID)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.blogStats", err)
+	}
+
+	reactions, err := usecase.store.CountBlogReactions(ctx, blogID)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.blogStats", err)
+	}
+
+	stats := &intfaces.BlogStats{
+		Views:     views + usecase.views.pending(blogID),
+		Reactions: make(map[sqlc.ReactionKind]int64, len(reactions)),
+	}
+
+	for _, reaction := range reactions {
+		stats.Reactions[reaction.Kind] = reaction.Count
+	}
+
+	if identity == nil || len(reactions) == 0 {
+		return stats, nil
+	}
+
+	mine, err := usecase.store.GetBlogReaction(ctx, sqlc.GetBlogReactionParams{BlogID: blogID, UserID: identity.UserID})
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, blogStoreError("uc.usecase.blogStats", err)
+	}
+
+	stats.MyReaction = mine.Kind
+
+	return stats, nil
+}
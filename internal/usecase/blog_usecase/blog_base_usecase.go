@@ -8,6 +8,8 @@ import (
 type BlogUseCase struct {
 	config *config.Config
 	store  intfaces.Store
+	// views are counted in memory and flushed by FlushBlogViews so that reading a blog does not write to it -.
+	views viewBuffer
 }
 
 func NewBlogUseCase(store intfaces.Store, config *config.Config) intfaces.IntBlogUsecase {
@@ -0,0 +1,147 @@
+package blog_usecase
+
+import (
+	"database/sql"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// _maxSortFields is the number of sort keys the ListBlog query has a CASE for -.
+	_maxSortFields = 3
+	_defaultSort   = "created_at"
+)
+
+// listFilters are the validated ListBlogsParams.Filters ready to be bound to the queries -.
+type listFilters struct {
+	status        sql.NullString
+	userRole      sql.NullString
+	createdAfter  sql.NullTime
+	createdBefore sql.NullTime
+	// tags are slugs, nil leaves the listing unfiltered -.
+	tags         []string
+	matchAllTags bool
+}
+
+// parseListFilters checks the filters against intfaces.ListBlogsFilterFields and their values against the allowed ones -.
+func parseListFilters(filters map[string]string) (listFilters, error) {
+	var parsed listFilters
+
+	for key, value := range filters {
+		if !slices.Contains(intfaces.ListBlogsFilterFields, key) {
+			return parsed, invalidQueryParameter("filter", "unknown filter "+key, intfaces.ListBlogsFilterFields)
+		}
+
+		if value == "" {
+			continue
+		}
+
+		switch key {
+		case "status":
+			if !slices.Contains(sqlc.AllBlogStatusValues(), sqlc.BlogStatus(value)) {
+				return parsed, invalidQueryParameter("filter[status]", "unknown status "+value, sqlc.AllBlogStatusValues())
+			}
+
+			parsed.status = sql.NullString{String: value, Valid: true}
+		case "user_role":
+			if !slices.Contains(sqlc.AllUserRolesValues(), sqlc.UserRoles(value)) {
+				return parsed, invalidQueryParameter("user_role", "unknown user role "+value, sqlc.AllUserRolesValues())
+			}
+
+			parsed.userRole = sql.NullString{String: value, Valid: true}
+		case "created_after", "created_before":
+			at, err := parseFilterTime(value)
+
+			if err != nil {
+				return parsed, entity.ErrBadRequest.WithMessage("enter an RFC 3339 time or a date for " + key).
+					WithDetails(map[string]interface{}{"parameter": key}).Wrap(err)
+			}
+
+			if key == "created_after" {
+				parsed.createdAfter = sql.NullTime{Time: at, Valid: true}
+			} else {
+				parsed.createdBefore = sql.NullTime{Time: at, Valid: true}
+			}
+		}
+	}
+
+	return parsed, nil
+}
+
+// parseListSort checks the sort keys against intfaces.ListBlogsSortFields, the blogs are sorted by created_at by default -.
+func parseListSort(sort string) ([_maxSortFields]string, error) {
+	var keys [_maxSortFields]string
+
+	if sort == "" {
+		keys[0] = _defaultSort
+		return keys, nil
+	}
+
+	fields := strings.Split(sort, ",")
+
+	if len(fields) > _maxSortFields {
+		return keys, invalidQueryParameter("sort", "sort by at most 3 fields", intfaces.ListBlogsSortFields)
+	}
+
+	for i, field := range fields {
+		field = strings.TrimSpace(field)
+
+		if !slices.Contains(intfaces.ListBlogsSortFields, strings.TrimPrefix(field, "-")) {
+			return keys, invalidQueryParameter("sort", "unknown sort field "+field, intfaces.ListBlogsSortFields)
+		}
+
+		keys[i] = field
+	}
+
+	return keys, nil
+}
+
+// parseListFields converts the selected fields into the json keys to render -.
+func parseListFields(fields string) ([]string, error) {
+	if fields == "" {
+		return nil, nil
+	}
+
+	var keys []string
+
+	for _, field := range strings.Split(fields, ",") {
+		key, ok := intfaces.ListBlogsFields[strings.TrimSpace(field)]
+
+		if !ok {
+			allowed := make([]string, 0, len(intfaces.ListBlogsFields))
+
+			for name := range intfaces.ListBlogsFields {
+				allowed = append(allowed, name)
+			}
+
+			sort.Strings(allowed)
+
+			return nil, invalidQueryParameter("fields", "unknown field "+field, allowed)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func parseFilterTime(value string) (time.Time, error) {
+	if at, err := time.Parse(time.RFC3339, value); err == nil {
+		return at, nil
+	}
+
+	return time.Parse(time.DateOnly, value)
+}
+
+// invalidQueryParameter is the bad request returned for values outside a whitelist, the allowed values are passed to the client -.
+func invalidQueryParameter(parameter string, message string, allowed interface{}) error {
+	return entity.ErrBadRequest.WithMessage(message).WithDetails(map[string]interface{}{
+		"parameter": parameter,
+		"allowed":   allowed,
+	})
+}
@@ -0,0 +1,86 @@
+package blog_usecase
+
+import (
+	"context"
+	"database/sql"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"time"
+)
+
+// PublishBlog publishes the blog now, a publishAt in the future schedules it instead -.
+func (usecase *BlogUseCase) PublishBlog(ctx context.Context, id string, publishAt *time.Time, precondition entity.Precondition) (*sqlc.Blog, error) {
+	current, err := usecase.ownedBlog(ctx, id, entity.PermissionBlogPublish, precondition)
+
+	if err != nil {
+		return nil, err
+	}
+
+	status, at := sqlc.BlogStatusPublished, time.Now()
+
+	if publishAt != nil && publishAt.After(at) {
+		status, at = sqlc.BlogStatusScheduled, *publishAt
+	}
+
+	return usecase.transitionBlog(ctx, current, status, sql.NullTime{Time: at, Valid: true})
+}
+
+// UnpublishBlog moves a scheduled, published or archived blog back to draft -.
+func (usecase *BlogUseCase) UnpublishBlog(ctx context.Context, id string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	current, err := usecase.ownedBlog(ctx, id, entity.PermissionBlogPublish, precondition)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.transitionBlog(ctx, current, sqlc.BlogStatusDraft, sql.NullTime{})
+}
+
+// ArchiveBlog retires a blog, the published_at of published blogs is kept as a record -.
+func (usecase *BlogUseCase) ArchiveBlog(ctx context.Context, id string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	current, err := usecase.ownedBlog(ctx, id, entity.PermissionBlogPublish, precondition)
+
+	if err != nil {
+		return nil, err
+	}
+
+	publishedAt := sql.NullTime{}
+
+	if current.Status == sqlc.BlogStatusPublished {
+		publishedAt = current.PublishedAt
+	}
+
+	return usecase.transitionBlog(ctx, current, sqlc.BlogStatusArchived, publishedAt)
+}
+
+// PublishDueBlogs publishes up to limit scheduled blogs whose publish time has passed -.
+func (usecase *BlogUseCase) PublishDueBlogs(ctx context.Context, limit int32) ([]sqlc.Blog, error) {
+	blogs, err := usecase.store.PublishDueBlogs(ctx, limit)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.PublishDueBlogs", err)
+	}
+
+	return blogs, nil
+}
+
+// transitionBlog validates the move against the status machine and applies it only if the blog is still at the version it was read at -.
+func (usecase *BlogUseCase) transitionBlog(ctx context.Context, current *sqlc.Blog, to sqlc.BlogStatus, publishedAt sql.NullTime) (*sqlc.Blog, error) {
+	if err := entity.TransitionBlog(entity.BlogStatus(current.Status), entity.BlogStatus(to)); err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.store.UpdateBlogStatus(ctx, sqlc.UpdateBlogStatusParams{
+		Status:      to,
+		PublishedAt: publishedAt,
+		ID:          current.ID,
+		FromStatus:  current.Status,
+		Version:     current.Version,
+	})
+
+	if err != nil {
+		return nil, blogChangeError("uc.usecase.transitionBlog", err)
+	}
+
+	return &blog, nil
+}
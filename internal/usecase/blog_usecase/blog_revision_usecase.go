@@ -0,0 +1,188 @@
+package blog_usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+)
+
+// ListBlogRevisions lists the revisions of a blog, latest first -.
+func (usecase *BlogUseCase) ListBlogRevisions(ctx context.Context, id string, args intfaces.ListBlogRevisionsParams) (*intfaces.ListBlogRevisionsResponse, error) {
+	blog, err := usecase.authoredBlog(ctx, id, entity.PermissionBlogUpdate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	paginator, err := utils.ParsePaginator(args.Page, args.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	Limit, Offset := paginator.Params()
+
+	revisions, err := usecase.store.ListBlogRevisions(ctx, sqlc.ListBlogRevisionsParams{
+		BlogID: blog.ID,
+		Limit:  Limit,
+		Offset: Offset,
+	})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListBlogRevisions", err)
+	}
+
+	revisions, nextPage, previousPage, meta := utils.PaginatorPage(ctx, paginator, revisions)
+
+	response := &intfaces.ListBlogRevisionsResponse{
+		Revisions:    revisions,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
+		PageMeta:     meta,
+	}
+
+	if args.SkipCount {
+		return response, nil
+	}
+
+	total, err := usecase.store.CountBlogRevisions(ctx, blog.ID)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListBlogRevisions", err)
+	}
+
+	utils.SetPageTotals(&response.PageMeta, total)
+
+	return response, nil
+}
+
+// GetBlogRevision fetches the content of a blog at a revision -.
+func (usecase *BlogUseCase) GetBlogRevision(ctx context.Context, id string, revision string) (*sqlc.BlogRevision, error) {
+	blog, err := usecase.authoredBlog(ctx, id, entity.PermissionBlogUpdate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.blogRevision(ctx, blog.ID, revision)
+}
+
+// DiffBlogRevisions diffs the content of a blog from one revision to another line by line -.
+func (usecase *BlogUseCase) DiffBlogRevisions(ctx context.Context, id string, from string, to string) (*intfaces.BlogRevisionDiff, error) {
+	blog, err := usecase.authoredBlog(ctx, id, entity.PermissionBlogUpdate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	old, err := usecase.blogRevision(ctx, blog.ID, from)
+
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := usecase.blogRevision(ctx, blog.ID, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &intfaces.BlogRevisionDiff{From: old.Revision, To: revision.Revision, Changes: []intfaces.BlogFieldDiff{}}
+
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{"title", old.Title, revision.Title},
+		{"summary", old.Summary.String, revision.Summary.String},
+		{"descriptions", old.Descriptions.String, revision.Descriptions.String},
+		{"body", old.Body, revision.Body},
+	}
+
+	for _, field := range fields {
+		if field.from == field.to {
+			continue
+		}
+
+		diff.Changes = append(diff.Changes, intfaces.BlogFieldDiff{Field: field.name, Lines: entity.DiffLines(field.from, field.to)})
+	}
+
+	return diff, nil
+}
+
+// RestoreBlogRevision brings back the content of a revision, the restore is itself recorded as a new revision -.
+func (usecase *BlogUseCase) RestoreBlogRevision(ctx context.Context, id string, revision string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	current, err := usecase.ownedBlog(ctx, id, entity.PermissionBlogUpdate, precondition)
+
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := usecase.blogRevision(ctx, current.ID, revision)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.changeContent(ctx, func(q sqlc.Querier) (sqlc.Blog, error) {
+		return q.UpdateBlog(ctx, sqlc.UpdateBlogParams{
+			ID:           current.ID,
+			Title:        restored.Title,
+			Body:         restored.Body,
+			Summary:      restored.Summary,
+			Descriptions: restored.Descriptions,
+			Version:      current.Version,
+		})
+	})
+
+	if err != nil {
+		return nil, blogChangeError("uc.usecase.RestoreBlogRevision", err)
+	}
+
+	return blog, nil
+}
+
+// blogRevision fetches a revision of a blog, unknown revisions are not found -.
+func (usecase *BlogUseCase) blogRevision(ctx context.Context, blogID uuid.UUID, revision string) (*sqlc.BlogRevision, error) {
+	number, err := utils.StringToInt32(revision)
+
+	if err != nil || number < 1 {
+		return nil, entity.ErrBadRequest.WithMessage("invalid revision").Wrap(err)
+	}
+
+	found, err := usecase.store.GetBlogRevision(ctx, sqlc.GetBlogRevisionParams{BlogID: blogID, Revision: number})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrNotFound.WithMessage(fmt.Sprintf("revision %d of the blog not found", number)).Wrap(err)
+		}
+
+		return nil, blogStoreError("uc.usecase.blogRevision", err)
+	}
+
+	return &found, nil
+}
+
+// newRevision snapshots the content of the blog at its version, the caller making the change is the editor -.
+func newRevision(ctx context.Context, blog sqlc.Blog) sqlc.CreateBlogRevisionParams {
+	params := sqlc.CreateBlogRevisionParams{
+		BlogID:       blog.ID,
+		Revision:     blog.Version,
+		Title:        blog.Title,
+		Body:         blog.Body,
+		Summary:      blog.Summary,
+		Descriptions: blog.Descriptions,
+	}
+
+	if identity, ok := entity.IdentityFromContext(ctx); ok {
+		params.EditorID = uuid.NullUUID{UUID: identity.UserID, Valid: true}
+	}
+
+	return params
+}
@@ -0,0 +1,70 @@
+package blog_usecase
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+)
+
+// SearchBlogs full text search over the blogs the caller can see, best matches first -.
+func (usecase *BlogUseCase) SearchBlogs(ctx context.Context, args intfaces.SearchBlogsParams) (*intfaces.SearchBlogsResponse, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionBlogRead)
+
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := utils.ToTsQuery(args.Query)
+
+	if err != nil {
+		return nil, entity.ErrBadRequest.WithMessage("enter a word to search for in the q query parameter").Wrap(err)
+	}
+
+	paginator, err := utils.ParsePaginator(args.Page, args.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	Limit, Offset := paginator.Params()
+
+	blogs, err := usecase.store.SearchBlogs(ctx, sqlc.SearchBlogsParams{
+		Query:    query,
+		ViewerID: uuid.NullUUID{UUID: identity.UserID, Valid: true},
+		Limit:    Limit,
+		Offset:   Offset,
+	})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.SearchBlogs", err)
+	}
+
+	blogs, nextPage, previousPage, meta := utils.PaginatorPage(ctx, paginator, blogs)
+
+	response := &intfaces.SearchBlogsResponse{
+		Blog:         blogs,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
+		PageMeta:     meta,
+	}
+
+	if args.SkipCount {
+		return response, nil
+	}
+
+	total, err := usecase.store.CountSearchBlogs(ctx, sqlc.CountSearchBlogsParams{
+		Query:    query,
+		ViewerID: uuid.NullUUID{UUID: identity.UserID, Valid: true},
+	})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.SearchBlogs", err)
+	}
+
+	utils.SetPageTotals(&response.PageMeta, total)
+
+	return response, nil
+}
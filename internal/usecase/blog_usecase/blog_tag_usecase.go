@@ -0,0 +1,168 @@
+package blog_usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+	"slices"
+	"strings"
+)
+
+const (
+	// _maxBlogTags bounds both the tags of a blog and the tags a listing is filtered by -.
+	_maxBlogTags  = 10
+	_maxTagLength = 50
+)
+
+// blogTags are the tags of a blog ready to be stored, the names and slugs line up and the slugs are unique -.
+type blogTags struct {
+	names []string
+	slugs []string
+}
+
+// ListTags lists the tags, most used first -.
+func (usecase *BlogUseCase) ListTags(ctx context.Context, args intfaces.ListTagsParams) (*intfaces.ListTagsResponse, error) {
+	if _, err := entity.Authorize(ctx, entity.PermissionBlogRead); err != nil {
+		return nil, err
+	}
+
+	paginator, err := utils.ParsePaginator(args.Page, args.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	Limit, Offset := paginator.Params()
+
+	tags, err := usecase.store.ListTags(ctx, sqlc.ListTagsParams{Limit: Limit, Offset: Offset})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListTags", err)
+	}
+
+	tags, nextPage, previousPage, meta := utils.PaginatorPage(ctx, paginator, tags)
+
+	response := &intfaces.ListTagsResponse{
+		Tags:         tags,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
+		PageMeta:     meta,
+	}
+
+	if args.SkipCount {
+		return response, nil
+	}
+
+	total, err := usecase.store.CountTags(ctx)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListTags", err)
+	}
+
+	utils.SetPageTotals(&response.PageMeta, total)
+
+	return response, nil
+}
+
+// ListBlogTags lists the tags of a blog the caller can see, in the order of their slugs -.
+func (usecase *BlogUseCase) ListBlogTags(ctx context.Context, id string) ([]sqlc.Tag, error) {
+	blog, err := usecase.GetBlog(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := usecase.store.ListBlogTags(ctx, blog.ID)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListBlogTags", err)
+	}
+
+	return tags, nil
+}
+
+// parseBlogTags trims the tag names and drops the ones sharing a slug with an earlier tag. The invalid tags are
+// reported as the field of the request they were sent in -.
+func parseBlogTags(names []string, field string, in string) (*blogTags, error) {
+	if names == nil {
+		return nil, nil
+	}
+
+	tags := &blogTags{names: []string{}, slugs: []string{}}
+
+	for i, name := range names {
+		name = strings.TrimSpace(name)
+		slug := utils.Slugify(name)
+
+		if slug == "" || len(name) > _maxTagLength {
+			return nil, entity.InvalidFields(entity.FieldError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				In:      in,
+				Code:    "invalid_tag",
+				Message: fmt.Sprintf("must be at most %d characters long with a letter or a digit", _maxTagLength),
+			})
+		}
+
+		if slices.Contains(tags.slugs, slug) {
+			continue
+		}
+
+		tags.names = append(tags.names, name)
+		tags.slugs = append(tags.slugs, slug)
+	}
+
+	if len(tags.slugs) > _maxBlogTags {
+		return nil, entity.InvalidFields(entity.FieldError{
+			Field:   field,
+			In:      in,
+			Code:    "too_many",
+			Message: fmt.Sprintf("must have at most %d items", _maxBlogTags),
+		})
+	}
+
+	return tags, nil
+}
+
+// parseTagFilter converts the tags a listing is filtered by into their slugs, no tags leaves the listing unfiltered -.
+func parseTagFilter(names []string, match string) ([]string, bool, error) {
+	if match != "" && !slices.Contains(intfaces.ListBlogsTagMatches, match) {
+		return nil, false, invalidQueryParameter("tag_match", "unknown tag match "+match, intfaces.ListBlogsTagMatches)
+	}
+
+	if len(names) == 0 {
+		return nil, false, nil
+	}
+
+	tags, err := parseBlogTags(names, "tag", "query")
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return tags.slugs, match == "all", nil
+}
+
+// setBlogTags replaces the tags of a blog, nil tags leave them as they are -.
+func setBlogTags(ctx context.Context, q sqlc.Querier, blogID uuid.UUID, tags *blogTags) error {
+	if tags == nil {
+		return nil
+	}
+
+	if err := q.DeleteBlogTags(ctx, blogID); err != nil {
+		return err
+	}
+
+	if len(tags.slugs) == 0 {
+		return nil
+	}
+
+	if _, err := q.UpsertTags(ctx, sqlc.UpsertTagsParams{Names: tags.names, Slugs: tags.slugs}); err != nil {
+		return err
+	}
+
+	return q.AddBlogTags(ctx, sqlc.AddBlogTagsParams{BlogID: blogID, Slugs: tags.slugs})
+}
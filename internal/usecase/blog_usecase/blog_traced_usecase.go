@@ -0,0 +1,308 @@
+package blog_usecase
+
+import (
+	"context"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const _blogIDKey = attribute.Key("blog.id")
+
+// tracedBlogUseCase runs every method of the blog usecase in a span, the queries the method runs are its children -.
+type tracedBlogUseCase struct {
+	next   intfaces.IntBlogUsecase
+	tracer trace.Tracer
+}
+
+var _ intfaces.IntBlogUsecase = (*tracedBlogUseCase)(nil)
+
+// NewTracedBlogUseCase wraps the blog usecase with the spans of its methods -.
+func NewTracedBlogUseCase(next intfaces.IntBlogUsecase) intfaces.IntBlogUsecase {
+	return &tracedBlogUseCase{
+		next:   next,
+		tracer: tracing.Tracer(),
+	}
+}
+
+// GetBlog -.
+func (usecase *tracedBlogUseCase) GetBlog(ctx context.Context, id string) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "GetBlog", _blogIDKey.String(id))
+	res, err := usecase.next.GetBlog(ctx, id)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// GetBlogBySlug -.
+func (usecase *tracedBlogUseCase) GetBlogBySlug(ctx context.Context, slug string) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "GetBlogBySlug", attribute.String("blog.slug", slug))
+	res, err := usecase.next.GetBlogBySlug(ctx, slug)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// CreateBlog -.
+func (usecase *tracedBlogUseCase) CreateBlog(ctx context.Context, args intfaces.CreateBlogParams) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "CreateBlog")
+	res, err := usecase.next.CreateBlog(ctx, args)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ListBlogs -.
+func (usecase *tracedBlogUseCase) ListBlogs(ctx context.Context, args intfaces.ListBlogsParams) (*intfaces.ListBlogsResponse, error) {
+	ctx, span := usecase.start(ctx, "ListBlogs", listBlogsAttributes(args)...)
+	res, err := usecase.next.ListBlogs(ctx, args)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// SearchBlogs -.
+func (usecase *tracedBlogUseCase) SearchBlogs(ctx context.Context, args intfaces.SearchBlogsParams) (*intfaces.SearchBlogsResponse, error) {
+	ctx, span := usecase.start(ctx, "SearchBlogs", attribute.String("page", args.Page), attribute.String("limit", args.Limit), attribute.Bool("skip_count", args.SkipCount))
+	res, err := usecase.next.SearchBlogs(ctx, args)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// UpdateBlog -.
+func (usecase *tracedBlogUseCase) UpdateBlog(ctx context.Context, id string, args intfaces.UpdateBlogParams, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "UpdateBlog", _blogIDKey.String(id))
+	res, err := usecase.next.UpdateBlog(ctx, id, args, precondition)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// PatchBlog -.
+func (usecase *tracedBlogUseCase) PatchBlog(ctx context.Context, id string, args intfaces.PatchBlogParams, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "PatchBlog", _blogIDKey.String(id))
+	res, err := usecase.next.PatchBlog(ctx, id, args, precondition)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// DeleteBlog -.
+func (usecase *tracedBlogUseCase) DeleteBlog(ctx context.Context, id string, precondition entity.Precondition) error {
+	ctx, span := usecase.start(ctx, "DeleteBlog", _blogIDKey.String(id))
+	err := usecase.next.DeleteBlog(ctx, id, precondition)
+	endSpan(span, err)
+
+	return err
+}
+
+// PublishBlog -.
+func (usecase *tracedBlogUseCase) PublishBlog(ctx context.Context, id string, publishAt *time.Time, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "PublishBlog", _blogIDKey.String(id))
+	res, err := usecase.next.PublishBlog(ctx, id, publishAt, precondition)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// UnpublishBlog -.
+func (usecase *tracedBlogUseCase) UnpublishBlog(ctx context.Context, id string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "UnpublishBlog", _blogIDKey.String(id))
+	res, err := usecase.next.UnpublishBlog(ctx, id, precondition)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ArchiveBlog -.
+func (usecase *tracedBlogUseCase) ArchiveBlog(ctx context.Context, id string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "ArchiveBlog", _blogIDKey.String(id))
+	res, err := usecase.next.ArchiveBlog(ctx, id, precondition)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// PublishDueBlogs -.
+func (usecase *tracedBlogUseCase) PublishDueBlogs(ctx context.Context, limit int32) ([]sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "PublishDueBlogs", attribute.Int("limit", int(limit)))
+	res, err := usecase.next.PublishDueBlogs(ctx, limit)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ListTrashedBlogs -.
+func (usecase *tracedBlogUseCase) ListTrashedBlogs(ctx context.Context, args intfaces.ListTrashedBlogsParams) (*intfaces.ListBlogsResponse, error) {
+	ctx, span := usecase.start(ctx, "ListTrashedBlogs", attribute.String("page", args.Page), attribute.String("limit", args.Limit))
+	res, err := usecase.next.ListTrashedBlogs(ctx, args)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// RestoreBlog -.
+func (usecase *tracedBlogUseCase) RestoreBlog(ctx context.Context, id string) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "RestoreBlog", _blogIDKey.String(id))
+	res, err := usecase.next.RestoreBlog(ctx, id)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// PurgeTrashedBlogs -.
+func (usecase *tracedBlogUseCase) PurgeTrashedBlogs(ctx context.Context) (int64, error) {
+	ctx, span := usecase.start(ctx, "PurgeTrashedBlogs")
+	res, err := usecase.next.PurgeTrashedBlogs(ctx)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ListBlogRevisions -.
+func (usecase *tracedBlogUseCase) ListBlogRevisions(ctx context.Context, id string, args intfaces.ListBlogRevisionsParams) (*intfaces.ListBlogRevisionsResponse, error) {
+	ctx, span := usecase.start(ctx, "ListBlogRevisions", _blogIDKey.String(id))
+	res, err := usecase.next.ListBlogRevisions(ctx, id, args)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// GetBlogRevision -.
+func (usecase *tracedBlogUseCase) GetBlogRevision(ctx context.Context, id string, revision string) (*sqlc.BlogRevision, error) {
+	ctx, span := usecase.start(ctx, "GetBlogRevision", _blogIDKey.String(id), attribute.String("blog.revision", revision))
+	res, err := usecase.next.GetBlogRevision(ctx, id, revision)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// DiffBlogRevisions -.
+func (usecase *tracedBlogUseCase) DiffBlogRevisions(ctx context.Context, id string, from string, to string) (*intfaces.BlogRevisionDiff, error) {
+	ctx, span := usecase.start(ctx, "DiffBlogRevisions", _blogIDKey.String(id), attribute.String("blog.revision.from", from), attribute.String("blog.revision.to", to))
+	res, err := usecase.next.DiffBlogRevisions(ctx, id, from, to)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// RestoreBlogRevision -.
+func (usecase *tracedBlogUseCase) RestoreBlogRevision(ctx context.Context, id string, revision string, precondition entity.Precondition) (*sqlc.Blog, error) {
+	ctx, span := usecase.start(ctx, "RestoreBlogRevision", _blogIDKey.String(id), attribute.String("blog.revision", revision))
+	res, err := usecase.next.RestoreBlogRevision(ctx, id, revision, precondition)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ListTags -.
+func (usecase *tracedBlogUseCase) ListTags(ctx context.Context, args intfaces.ListTagsParams) (*intfaces.ListTagsResponse, error) {
+	ctx, span := usecase.start(ctx, "ListTags", attribute.String("page", args.Page), attribute.String("limit", args.Limit))
+	res, err := usecase.next.ListTags(ctx, args)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ListBlogTags -.
+func (usecase *tracedBlogUseCase) ListBlogTags(ctx context.Context, id string) ([]sqlc.Tag, error) {
+	ctx, span := usecase.start(ctx, "ListBlogTags", _blogIDKey.String(id))
+	res, err := usecase.next.ListBlogTags(ctx, id)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ViewBlog -.
+func (usecase *tracedBlogUseCase) ViewBlog(ctx context.Context, id string) (*intfaces.BlogView, error) {
+	ctx, span := usecase.start(ctx, "ViewBlog", _blogIDKey.String(id))
+	res, err := usecase.next.ViewBlog(ctx, id)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ReactToBlog -.
+func (usecase *tracedBlogUseCase) ReactToBlog(ctx context.Context, id string, kind string) (*intfaces.BlogStats, error) {
+	ctx, span := usecase.start(ctx, "ReactToBlog", _blogIDKey.String(id), attribute.String("blog.reaction", kind))
+	res, err := usecase.next.ReactToBlog(ctx, id, kind)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// RemoveBlogReaction -.
+func (usecase *tracedBlogUseCase) RemoveBlogReaction(ctx context.Context, id string) (*intfaces.BlogStats, error) {
+	ctx, span := usecase.start(ctx, "RemoveBlogReaction", _blogIDKey.String(id))
+	res, err := usecase.next.RemoveBlogReaction(ctx, id)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// ListPopularBlogs -.
+func (usecase *tracedBlogUseCase) ListPopularBlogs(ctx context.Context, args intfaces.ListPopularBlogsParams) (*intfaces.ListPopularBlogsResponse, error) {
+	ctx, span := usecase.start(ctx, "ListPopularBlogs")
+	res, err := usecase.next.ListPopularBlogs(ctx, args)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// FlushBlogViews -.
+func (usecase *tracedBlogUseCase) FlushBlogViews(ctx context.Context) (int64, error) {
+	ctx, span := usecase.start(ctx, "FlushBlogViews")
+	res, err := usecase.next.FlushBlogViews(ctx)
+	endSpan(span, err)
+
+	return res, err
+}
+
+// start -.
+func (usecase *tracedBlogUseCase) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return usecase.tracer.Start(ctx, "BlogUseCase."+method, trace.WithAttributes(attrs...))
+}
+
+// endSpan records the error on the span, only the server errors fail it since the client errors are answers -.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+
+		if entity.GetStatusCode(err) >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+
+	span.End()
+}
+
+// listBlogsAttributes are the parameters that change the queries of the listing the most, the filter values are left
+// out since they are user data -.
+func listBlogsAttributes(args intfaces.ListBlogsParams) []attribute.KeyValue {
+	filters := make([]string, 0, len(args.Filters))
+
+	for field := range args.Filters {
+		filters = append(filters, field)
+	}
+
+	slices.Sort(filters)
+
+	return []attribute.KeyValue{
+		attribute.String("page", args.Page),
+		attribute.String("limit", args.Limit),
+		attribute.Bool("use_cursor", args.UseCursor),
+		attribute.String("sort", args.Sort),
+		attribute.StringSlice("filters", filters),
+		attribute.Int("tag_count", len(args.Tags)),
+		attribute.String("tag_match", args.TagMatch),
+		attribute.Bool("skip_count", args.SkipCount),
+	}
+}
@@ -0,0 +1,98 @@
+package blog_usecase
+
+import (
+	"context"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+	"time"
+)
+
+// ListTrashedBlogs lists the deleted blogs of every author that can still be restored, latest deleted first -.
+func (usecase *BlogUseCase) ListTrashedBlogs(ctx context.Context, args intfaces.ListTrashedBlogsParams) (*intfaces.ListBlogsResponse, error) {
+	if _, err := entity.Authorize(ctx, entity.PermissionBlogTrash); err != nil {
+		return nil, err
+	}
+
+	authorID, err := parseAuthorID(args.AuthorID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	paginator, err := utils.ParsePaginator(args.Page, args.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	Limit, Offset := paginator.Params()
+
+	blogs, err := usecase.store.ListTrashedBlogs(ctx, sqlc.ListTrashedBlogsParams{
+		AuthorID: authorID,
+		Limit:    Limit,
+		Offset:   Offset,
+	})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListTrashedBlogs", err)
+	}
+
+	blogs, nextPage, previousPage, meta := utils.PaginatorPage(ctx, paginator, blogs)
+
+	response := &intfaces.ListBlogsResponse{
+		Blog:         blogs,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
+		PageMeta:     meta,
+	}
+
+	if args.SkipCount {
+		return response, nil
+	}
+
+	total, err := usecase.store.CountTrashedBlogs(ctx, authorID)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListTrashedBlogs", err)
+	}
+
+	utils.SetPageTotals(&response.PageMeta, total)
+
+	return response, nil
+}
+
+// RestoreBlog takes a blog out of the trash as it was when it was deleted -.
+func (usecase *BlogUseCase) RestoreBlog(ctx context.Context, id string) (*sqlc.Blog, error) {
+	if _, err := entity.Authorize(ctx, entity.PermissionBlogTrash); err != nil {
+		return nil, err
+	}
+
+	uuID, err := parseBlogID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.store.RestoreBlog(ctx, uuID)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.RestoreBlog", err)
+	}
+
+	return &blog, nil
+}
+
+// PurgeTrashedBlogs deletes the blogs that have been in the trash for longer than the configured retention -.
+func (usecase *BlogUseCase) PurgeTrashedBlogs(ctx context.Context) (int64, error) {
+	retention := time.Duration(usecase.config.Trash.RetentionDays) * 24 * time.Hour
+
+	purged, err := usecase.store.PurgeTrashedBlogs(ctx, time.Now().Add(-retention))
+
+	if err != nil {
+		return 0, blogStoreError("uc.usecase.PurgeTrashedBlogs", err)
+	}
+
+	return purged, nil
+}
@@ -10,62 +10,587 @@ import (
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+	"github.com/lib/pq"
+	"strings"
 )
 
+const (
+	// _defaultSlug is used for titles without a single letter or digit -.
+	_defaultSlug = "blog"
+	// _maxSlugAttempts is the number of suffixed slugs tried after the slug of the title is taken -.
+	_maxSlugAttempts = 5
+	// Postgres SQLSTATE returned when a unique constraint is violated -.
+	_uniqueViolation = "23505"
+	_slugConstraint  = "blog_slug_key"
+)
+
+var errMissingTitle = entity.ErrBadRequest.WithMessage("enter a title for the blog")
+
 // GetBlog getting a single blog by id -.
 func (usecase *BlogUseCase) GetBlog(ctx context.Context, id string) (*sqlc.Blog, error) {
-	uuID, err := uuid.Parse(id)
+	identity, err := entity.Authorize(ctx, entity.PermissionBlogRead)
+
+	if err != nil {
+		return nil, err
+	}
+
+	uuID, err := parseBlogID(id)
 
 	if err != nil {
-		return nil, fmt.Errorf("IntBlogUsecase - blog - uc.usecase.GetBlog: %w", err)
+		return nil, err
 	}
 
 	blog, err := usecase.store.GetBlog(ctx, uuID)
 	if err != nil {
-		return nil, entity.CreateError(entity.ErrNotFound.Error(), err.Error())
+		return nil, blogStoreError("uc.usecase.GetBlog", err)
 	}
 
-	return &blog, nil
+	return visibleBlog(identity, &blog)
 }
 
-func (usecase *BlogUseCase) CreateBlog(ctx context.Context, description string) (*sqlc.Blog, error) {
+// GetBlogBySlug getting a single blog by its slug -.
+func (usecase *BlogUseCase) GetBlogBySlug(ctx context.Context, slug string) (*sqlc.Blog, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionBlogRead)
 
-	blog, err := usecase.store.CreateBlog(ctx, sql.NullString{String: description, Valid: true})
+	if err != nil {
+		return nil, err
+	}
 
+	blog, err := usecase.store.GetBlogBySlug(ctx, slug)
 	if err != nil {
-		return nil, fmt.Errorf("IntBlogUsecase - uc.usecase.CreateBlog.: %w", err)
+		return nil, blogStoreError("uc.usecase.GetBlogBySlug", err)
 	}
 
-	return &blog, nil
+	return visibleBlog(identity, &blog)
+}
+
+// CreateBlog creates a draft blog with a unique slug generated from the title -.
+func (usecase *BlogUseCase) CreateBlog(ctx context.Context, args intfaces.CreateBlogParams) (*sqlc.Blog, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionBlogCreate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(args.Title)
+
+	if title == "" {
+		return nil, errMissingTitle
+	}
+
+	tags, err := parseBlogTags(args.Tags, "tags", "body")
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := sqlc.CreateBlogParams{
+		Title:        title,
+		Body:         args.Body,
+		Summary:      sql.NullString{String: args.Summary, Valid: args.Summary != ""},
+		Descriptions: sql.NullString{String: args.Description, Valid: true},
+		AuthorID:     uuid.NullUUID{UUID: identity.UserID, Valid: true},
+	}
+
+	slug := utils.Slugify(title)
+
+	if slug == "" {
+		slug = _defaultSlug
+	}
+
+	// Two blogs can share a title, the unique index decides and a random suffix is tried on a collision -.
+	for attempt := 0; ; attempt++ {
+		params.Slug = slug
+
+		if attempt > 0 {
+			params.Slug = slug + "-" + uuid.NewString()[:8]
+		}
+
+		blog, err := usecase.changeContent(ctx, func(q sqlc.Querier) (sqlc.Blog, error) {
+			blog, err := q.CreateBlog(ctx, params)
+
+			if err != nil {
+				return blog, err
+			}
+
+			return blog, setBlogTags(ctx, q, blog.ID, tags)
+		})
+
+		if err == nil {
+			return blog, nil
+		}
+
+		if !isSlugConflict(err) || attempt >= _maxSlugAttempts {
+			return nil, blogStoreError("uc.usecase.CreateBlog", err)
+		}
+	}
 }
 
 // ListBlogs -.
 func (usecase *BlogUseCase) ListBlogs(ctx context.Context, args intfaces.ListBlogsParams) (*intfaces.ListBlogsResponse, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionBlogRead)
 
-	page, err := utils.StringToInt32(args.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	authorID, err := parseAuthorID(args.AuthorID)
 
 	if err != nil {
-		return nil, errors.New("enter a valid type for the pageId query parameter")
+		return nil, err
 	}
 
-	limit, err := utils.StringToInt32(args.Limit)
+	filters, err := parseListFilters(args.Filters)
+
+	if err != nil {
+		return nil, err
+	}
+
+	filters.tags, filters.matchAllTags, err = parseTagFilter(args.Tags, args.TagMatch)
+
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := parseListFields(args.Fields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := parseListSort(args.Sort)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var response *intfaces.ListBlogsResponse
+
+	if args.UseCursor {
+		// The cursor is a (created_at, id) position hence the keyset queries can not follow any other order -.
+		if sort != [_maxSortFields]string{_defaultSort} {
+			return nil, invalidQueryParameter("sort", "cursor pagination only supports sorting by created_at", []string{_defaultSort})
+		}
+
+		response, err = usecase.listBlogsByCursor(ctx, args, authorID, filters, identity)
+	} else {
+		response, err = usecase.listBlogsByPage(ctx, args, authorID, filters, sort, identity)
+	}
 
 	if err != nil {
-		return nil, errors.New("enter a valid type for the pageSize query parameter")
+		return nil, err
+	}
+
+	response.Fields = fields
+
+	if args.SkipCount {
+		return response, nil
 	}
 
-	Limit, Offset := utils.PaginatorParams(page, limit)
+	total, err := usecase.store.CountBlogs(ctx, sqlc.CountBlogsParams{
+		AuthorID:      authorID,
+		ViewerID:      uuid.NullUUID{UUID: identity.UserID, Valid: true},
+		Status:        filters.status,
+		UserRole:      filters.userRole,
+		CreatedAfter:  filters.createdAfter,
+		CreatedBefore: filters.createdBefore,
+		Tags:          filters.tags,
+		MatchAllTags:  filters.matchAllTags,
+	})
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.ListBlogs", err)
+	}
+
+	utils.SetPageTotals(&response.PageMeta, total)
+
+	return response, nil
+}
+
+// listBlogsByPage pages through the blogs using limit and offset, it supports any of the sort orders -.
+func (usecase *BlogUseCase) listBlogsByPage(ctx context.Context, args intfaces.ListBlogsParams, authorID uuid.NullUUID, filters listFilters, sort [_maxSortFields]string, identity *entity.Identity) (*intfaces.ListBlogsResponse, error) {
+	paginator, err := utils.ParsePaginator(args.Page, args.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	Limit, Offset := paginator.Params()
 
 	blogs, err := usecase.store.ListBlog(ctx, sqlc.ListBlogParams{
-		Limit:  Limit,
-		Offset: Offset,
+		AuthorID:      authorID,
+		ViewerID:      uuid.NullUUID{UUID: identity.UserID, Valid: true},
+		Status:        filters.status,
+		UserRole:      filters.userRole,
+		CreatedAfter:  filters.createdAfter,
+		CreatedBefore: filters.createdBefore,
+		Tags:          filters.tags,
+		MatchAllTags:  filters.matchAllTags,
+		Sort1:         sort[0],
+		Sort2:         sort[1],
+		Sort3:         sort[2],
+		Limit:         Limit,
+		Offset:        Offset,
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("IntBlogUsecase - bank - uc.usecase.ListBlogs: %w", err)
+		return nil, blogStoreError("uc.usecase.ListBlogs", err)
+	}
+
+	blogs, nextPage, previousPage, meta := utils.PaginatorPage(ctx, paginator, blogs)
+
+	return &intfaces.ListBlogsResponse{
+		Blog:         blogs,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
+		PageMeta:     meta,
+	}, nil
+}
+
+// listBlogsByCursor pages through the blogs using (created_at, id) keyset queries -.
+func (usecase *BlogUseCase) listBlogsByCursor(ctx context.Context, args intfaces.ListBlogsParams, authorID uuid.NullUUID, filters listFilters, identity *entity.Identity) (*intfaces.ListBlogsResponse, error) {
+	limit, err := utils.StringToInt32(args.Limit)
+
+	if err != nil || limit <= 0 {
+		return nil, entity.ErrBadRequest.WithMessage("enter a valid value for the limit query parameter")
+	}
+
+	// No cursor means the first page, everything is after the zero position -.
+	cursor := utils.Cursor{Direction: utils.CursorNext}
+
+	if args.Cursor != "" {
+		cursor, err = utils.DecodeCursor(usecase.config.Paginator.CursorSecret, args.Cursor)
+
+		if err != nil {
+			return nil, entity.ErrBadRequest.WithMessage("invalid cursor").Wrap(err)
+		}
+	}
+
+	// Fetching an extra row tells whether there is more data in the direction of travel -.
+	var blogs []sqlc.Blog
+
+	if cursor.Direction == utils.CursorPrevious {
+		blogs, err = usecase.store.ListBlogBefore(ctx, sqlc.ListBlogBeforeParams{
+			CreatedAt:     cursor.CreatedAt,
+			ID:            cursor.ID,
+			AuthorID:      authorID,
+			ViewerID:      uuid.NullUUID{UUID: identity.UserID, Valid: true},
+			Status:        filters.status,
+			UserRole:      filters.userRole,
+			CreatedAfter:  filters.createdAfter,
+			CreatedBefore: filters.createdBefore,
+			Tags:          filters.tags,
+			MatchAllTags:  filters.matchAllTags,
+			Limit:         limit + 1,
+		})
+	} else {
+		blogs, err = usecase.store.ListBlogAfter(ctx, sqlc.ListBlogAfterParams{
+			CreatedAt:     cursor.CreatedAt,
+			ID:            cursor.ID,
+			AuthorID:      authorID,
+			ViewerID:      uuid.NullUUID{UUID: identity.UserID, Valid: true},
+			Status:        filters.status,
+			UserRole:      filters.userRole,
+			CreatedAfter:  filters.createdAfter,
+			CreatedBefore: filters.createdBefore,
+			Tags:          filters.tags,
+			MatchAllTags:  filters.matchAllTags,
+			Limit:         limit + 1,
+		})
+	}
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.listBlogsByCursor", err)
+	}
+
+	hasMore := len(blogs) > int(limit)
+
+	if hasMore {
+		blogs = blogs[:limit]
 	}
 
-	nextPage, previousPage := utils.PaginatorPages(ctx, page, limit, len(blogs))
+	// Rows before the cursor come back in descending order -.
+	if cursor.Direction == utils.CursorPrevious {
+		for i, j := 0, len(blogs)-1; i < j; i, j = i+1, j-1 {
+			blogs[i], blogs[j] = blogs[j], blogs[i]
+		}
+	}
+
+	response := &intfaces.ListBlogsResponse{Blog: blogs, PageMeta: intfaces.PageMeta{ItemsPerPage: limit}}
+
+	if len(blogs) == 0 {
+		return response, nil
+	}
+
+	first, last := blogs[0], blogs[len(blogs)-1]
+	backwards := cursor.Direction == utils.CursorPrevious
+
+	// A page reached from a cursor always has rows on the side it came from -.
+	if hasMore || backwards {
+		response.NextCursor = utils.EncodeCursor(usecase.config.Paginator.CursorSecret, utils.Cursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+			Direction: utils.CursorNext,
+		})
+	}
+
+	if hasMore && backwards || !backwards && args.Cursor != "" {
+		response.PrevCursor = utils.EncodeCursor(usecase.config.Paginator.CursorSecret, utils.Cursor{
+			CreatedAt: first.CreatedAt,
+			ID:        first.ID,
+			Direction: utils.CursorPrevious,
+		})
+	}
+
+	return response, nil
+}
+
+// UpdateBlog replaces all the editable fields of a blog -.
+func (usecase *BlogUseCase) UpdateBlog(ctx context.Context, id string, args intfaces.UpdateBlogParams, precondition entity.Precondition) (*sqlc.Blog, error) {
+	current, err := usecase.ownedBlog(ctx, id, entity.PermissionBlogUpdate, precondition)
+
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(args.Title)
+
+	if title == "" {
+		return nil, errMissingTitle
+	}
+
+	tags, err := parseBlogTags(args.Tags, "tags", "body")
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.changeContent(ctx, func(q sqlc.Querier) (sqlc.Blog, error) {
+		blog, err := q.UpdateBlog(ctx, sqlc.UpdateBlogParams{
+			ID:           current.ID,
+			Title:        title,
+			Body:         args.Body,
+			Summary:      sql.NullString{String: args.Summary, Valid: args.Summary != ""},
+			Descriptions: sql.NullString{String: args.Description, Valid: true},
+			Version:      current.Version,
+		})
+
+		if err != nil {
+			return blog, err
+		}
+
+		return blog, setBlogTags(ctx, q, blog.ID, tags)
+	})
+
+	if err != nil {
+		return nil, blogChangeError("uc.usecase.UpdateBlog", err)
+	}
+
+	return blog, nil
+}
+
+// PatchBlog updates only the fields passed in the args, the rest are left as they are -.
+func (usecase *BlogUseCase) PatchBlog(ctx context.Context, id string, args intfaces.PatchBlogParams, precondition entity.Precondition) (*sqlc.Blog, error) {
+	current, err := usecase.ownedBlog(ctx, id, entity.PermissionBlogUpdate, precondition)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := sqlc.PatchBlogParams{ID: current.ID, Version: current.Version}
+
+	if args.Title != nil {
+		title := strings.TrimSpace(*args.Title)
+
+		if title == "" {
+			return nil, errMissingTitle
+		}
+
+		params.Title = sql.NullString{String: title, Valid: true}
+	}
+
+	if args.Body != nil {
+		params.Body = sql.NullString{String: *args.Body, Valid: true}
+	}
+
+	// An empty summary clears it to null as it does on create and update -.
+	if args.Summary != nil {
+		params.SetSummary = true
+		params.Summary = sql.NullString{String: *args.Summary, Valid: *args.Summary != ""}
+	}
+
+	if args.Description != nil {
+		params.Descriptions = sql.NullString{String: *args.Description, Valid: true}
+	}
+
+	tags, err := parseBlogTags(args.Tags, "tags", "body")
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.changeContent(ctx, func(q sqlc.Querier) (sqlc.Blog, error) {
+		blog, err := q.PatchBlog(ctx, params)
+
+		if err != nil {
+			return blog, err
+		}
+
+		return blog, setBlogTags(ctx, q, blog.ID, tags)
+	})
+
+	if err != nil {
+		return nil, blogChangeError("uc.usecase.PatchBlog", err)
+	}
+
+	return blog, nil
+}
+
+// DeleteBlog moves a blog to the trash, returns a not found error if the blog does not exist -.
+func (usecase *BlogUseCase) DeleteBlog(ctx context.Context, id string, precondition entity.Precondition) error {
+	current, err := usecase.ownedBlog(ctx, id, entity.PermissionBlogDelete, precondition)
+
+	if err != nil {
+		return err
+	}
+
+	trashed, err := usecase.store.TrashBlog(ctx, sqlc.TrashBlogParams{ID: current.ID, Version: current.Version})
+
+	if err != nil {
+		return blogStoreError("uc.usecase.DeleteBlog", err)
+	}
+
+	if trashed == 0 {
+		return blogChangeError("uc.usecase.DeleteBlog", sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// ownedBlog fetches a blog the caller is about to change, checks the caller owns it and that it is still at the
+// version the caller read -.
+func (usecase *BlogUseCase) ownedBlog(ctx context.Context, id string, permission entity.Permission, precondition entity.Precondition) (*sqlc.Blog, error) {
+	blog, err := usecase.authoredBlog(ctx, id, permission)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the owner learns that the blog exists at another version -.
+	if err = precondition.Check(entity.ETag(blog.ID, blog.Version)); err != nil {
+		return nil, err
+	}
+
+	return blog, nil
+}
+
+// authoredBlog fetches a blog and checks the caller owns it -.
+func (usecase *BlogUseCase) authoredBlog(ctx context.Context, id string, permission entity.Permission) (*sqlc.Blog, error) {
+	// Checking the role first spares the database lookup for callers who can never change a blog -.
+	if _, err := entity.Authorize(ctx, permission); err != nil {
+		return nil, err
+	}
+
+	uuID, err := parseBlogID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.store.GetBlog(ctx, uuID)
+
+	if err != nil {
+		return nil, blogStoreError("uc.usecase.authoredBlog", err)
+	}
+
+	if _, err = entity.AuthorizeOwner(ctx, permission, blog.AuthorID); err != nil {
+		return nil, err
+	}
+
+	return &blog, nil
+}
+
+// changeContent runs a change of the content of a blog and records the content it leaves as a revision in the same
+// transaction, so that the history never misses a version -.
+func (usecase *BlogUseCase) changeContent(ctx context.Context, change func(q sqlc.Querier) (sqlc.Blog, error)) (*sqlc.Blog, error) {
+	var blog sqlc.Blog
+
+	err := usecase.store.WithTx(ctx, nil, func(q sqlc.Querier) error {
+		var err error
+
+		if blog, err = change(q); err != nil {
+			return err
+		}
+
+		_, err = q.CreateBlogRevision(ctx, newRevision(ctx, blog))
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &blog, nil
+}
+
+// visibleBlog hides the blogs that are not published from everyone but their author.
+// Not found is returned rather than forbidden so that unpublished blogs can not be discovered -.
+func visibleBlog(identity *entity.Identity, blog *sqlc.Blog) (*sqlc.Blog, error) {
+	if blog.Status != sqlc.BlogStatusPublished && (!blog.AuthorID.Valid || blog.AuthorID.UUID != identity.UserID) {
+		return nil, entity.ErrNotFound.WithMessage("blog not found")
+	}
+
+	return blog, nil
+}
+
+// isSlugConflict reports whether the insert failed because another blog has the slug -.
+func isSlugConflict(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == _uniqueViolation && pqErr.Constraint == _slugConstraint
+}
+
+// parseBlogID converts the blog id into a uuid, invalid ids are a bad request -.
+func parseBlogID(id string) (uuid.UUID, error) {
+	uuID, err := uuid.Parse(id)
+
+	if err != nil {
+		return uuid.Nil, entity.ErrBadRequest.WithMessage("invalid blog id").Wrap(err)
+	}
+
+	return uuID, nil
+}
+
+// parseAuthorID converts the optional author filter, an empty id lists the blogs of all authors -.
+func parseAuthorID(id string) (uuid.NullUUID, error) {
+	if id == "" {
+		return uuid.NullUUID{}, nil
+	}
+
+	uuID, err := uuid.Parse(id)
+
+	if err != nil {
+		return uuid.NullUUID{}, entity.ErrBadRequest.WithMessage("invalid author id").Wrap(err)
+	}
+
+	return uuid.NullUUID{UUID: uuID, Valid: true}, nil
+}
+
+// blogChangeError a change guarded by the version finds no rows when the blog was changed or deleted after it was read -.
+func blogChangeError(op string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrPreconditionFailed.WithMessage("the blog was changed by another request, fetch it again").Wrap(err)
+	}
+
+	return blogStoreError(op, err)
+}
+
+// blogStoreError maps repository errors to the entity errors -.
+func blogStoreError(op string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrNotFound.WithMessage("blog not found").Wrap(err)
+	}
 
-	return &intfaces.ListBlogsResponse{Blog: blogs, NextPage: nextPage, PreviousPage: previousPage}, nil
+	return entity.ErrInternalServerError.Wrap(fmt.Errorf("IntBlogUsecase - %s: %w", op, err))
 }
@@ -1,18 +1,46 @@
 package blog_usecase
 
 import (
+	"context"
 	"database/sql"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/harmannkibue/golang_gin_clean_architecture/config"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
 	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/mocks"
 	db "github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/tracing"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
 	testing2 "testing"
 	"time"
 )
 
+var (
+	testAuthor = &entity.Identity{UserID: uuid.New(), Role: entity.RoleAuthor}
+	testReader = &entity.Identity{UserID: uuid.New(), Role: entity.RoleReader}
+	testAdmin  = &entity.Identity{UserID: uuid.New(), Role: entity.RoleAdmin}
+)
+
+// inTx runs the transactions of the usecase straight on the mock store -.
+func inTx(mockStore *mocks.Store, ctx context.Context) {
+	mockStore.On("WithTx", ctx, (*intfaces.TxOptions)(nil), mock.Anything).
+		Return(func(ctx context.Context, _ *intfaces.TxOptions, fn func(db.Querier) error) error {
+			return fn(mockStore)
+		})
+}
+
 func TestMockGettingBlog(t *testing2.T) {
 	mockStore := new(mocks.Store)
 	blogId, _ := uuid.Parse("93979a30-a3a9-4910-aa20-3fd5f14b69f9")
@@ -20,13 +48,17 @@ func TestMockGettingBlog(t *testing2.T) {
 		ID:           blogId,
 		Descriptions: sql.NullString{String: "Blog description", Valid: true},
 		UserRole:     "author",
+		Status:       db.BlogStatusPublished,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    sql.NullTime{Time: time.Now(), Valid: true},
 	}
 
 	t.Run("success", func(t *testing2.T) {
 		res := httptest.NewRecorder()
-		ctx, _ := gin.CreateTestContext(res)
+		ctx, engine := gin.CreateTestContext(res)
+		engine.ContextWithFallback = true
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blogs/"+blogId.String(), nil).
+			WithContext(entity.ContextWithIdentity(context.Background(), testReader))
 		mockStore.On("GetBlog", ctx, blogId).Return(mockBlog, nil).Once()
 		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
 
@@ -37,4 +69,1000 @@ func TestMockGettingBlog(t *testing2.T) {
 
 		mockStore.AssertExpectations(t)
 	})
+
+	t.Run("draft is hidden from readers", func(t *testing2.T) {
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		draft := mockBlog
+		draft.Status = db.BlogStatusDraft
+		draft.AuthorID = uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}
+		mockStore.On("GetBlogBySlug", ctx, "a-draft").Return(draft, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.GetBlogBySlug(ctx, "a-draft")
+
+		assert.Equal(t, http.StatusNotFound, entity.GetStatusCode(err))
+		mockStore.AssertExpectations(t)
+	})
+}
+
+func TestMockCreatingBlog(t *testing2.T) {
+	slugTaken := &pq.Error{Code: "23505", Constraint: "blog_slug_key"}
+
+	t.Run("slug collision retries with a suffix", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		created := db.Blog{ID: uuid.New(), Title: "Hello, World!", Slug: "hello-world-1a2b3c4d", Version: 1}
+		inTx(mockStore, ctx)
+		mockStore.On("CreateBlog", ctx, mock.MatchedBy(func(args db.CreateBlogParams) bool {
+			return args.Slug == "hello-world"
+		})).Return(db.Blog{}, slugTaken).Once()
+		mockStore.On("CreateBlog", ctx, mock.MatchedBy(func(args db.CreateBlogParams) bool {
+			return strings.HasPrefix(args.Slug, "hello-world-") && args.AuthorID.UUID == testAuthor.UserID
+		})).Return(created, nil).Once()
+		mockStore.On("CreateBlogRevision", ctx, db.CreateBlogRevisionParams{
+			BlogID:   created.ID,
+			Revision: 1,
+			Title:    "Hello, World!",
+			EditorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true},
+		}).Return(db.BlogRevision{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		blog, err := blogUsecase.CreateBlog(ctx, intfaces.CreateBlogParams{Title: " Hello, World! "})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "hello-world-1a2b3c4d", blog.Slug)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("missing title", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.CreateBlog(entity.ContextWithIdentity(context.Background(), testAuthor), intfaces.CreateBlogParams{Title: "  "})
+
+		assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "CreateBlog", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMockPublishingBlog(t *testing2.T) {
+	blogId := uuid.New()
+	owned := func(status db.BlogStatus) db.Blog {
+		return db.Blog{ID: blogId, Status: status, Version: 2, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}
+	}
+	current := entity.Precondition{ETags: []string{entity.ETag(blogId, 2)}}
+
+	t.Run("publish now", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned(db.BlogStatusDraft), nil).Once()
+		mockStore.On("UpdateBlogStatus", ctx, mock.MatchedBy(func(args db.UpdateBlogStatusParams) bool {
+			return args.Status == db.BlogStatusPublished && args.FromStatus == db.BlogStatusDraft && args.Version == 2 && args.PublishedAt.Valid
+		})).Return(owned(db.BlogStatusPublished), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		blog, err := blogUsecase.PublishBlog(ctx, blogId.String(), nil, current)
+
+		assert.NoError(t, err)
+		assert.Equal(t, db.BlogStatusPublished, blog.Status)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("publish later schedules", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		publishAt := time.Now().Add(time.Hour)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned(db.BlogStatusDraft), nil).Once()
+		mockStore.On("UpdateBlogStatus", ctx, db.UpdateBlogStatusParams{
+			Status:      db.BlogStatusScheduled,
+			PublishedAt: sql.NullTime{Time: publishAt, Valid: true},
+			ID:          blogId,
+			FromStatus:  db.BlogStatusDraft,
+			Version:     2,
+		}).Return(owned(db.BlogStatusScheduled), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.PublishBlog(ctx, blogId.String(), &publishAt, current)
+
+		assert.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("archived blog can not be published", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned(db.BlogStatusArchived), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.PublishBlog(ctx, blogId.String(), nil, current)
+
+		assert.Equal(t, http.StatusConflict, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "UpdateBlogStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("concurrent transition", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned(db.BlogStatusPublished), nil).Once()
+		mockStore.On("UpdateBlogStatus", ctx, mock.AnythingOfType("sqlc.UpdateBlogStatusParams")).Return(db.Blog{}, sql.ErrNoRows).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.ArchiveBlog(ctx, blogId.String(), current)
+
+		// Losing the race is the same stale version as a change of the blog -.
+		assert.Equal(t, http.StatusPreconditionFailed, entity.GetStatusCode(err))
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("reader is forbidden", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.UnpublishBlog(entity.ContextWithIdentity(context.Background(), testReader), blogId.String(), current)
+
+		assert.Equal(t, http.StatusForbidden, entity.GetStatusCode(err))
+	})
+}
+
+func TestMockDeletingBlog(t *testing2.T) {
+	blogId, _ := uuid.Parse("93979a30-a3a9-4910-aa20-3fd5f14b69f9")
+	current := entity.Precondition{ETags: []string{entity.ETag(blogId, 1)}}
+
+	t.Run("success", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(db.Blog{ID: blogId, Version: 1, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}, nil).Once()
+		mockStore.On("TrashBlog", ctx, db.TrashBlogParams{ID: blogId, Version: 1}).Return(int64(1), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		err := blogUsecase.DeleteBlog(ctx, blogId.String(), current)
+
+		assert.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("missing if match", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(db.Blog{ID: blogId, Version: 1, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		err := blogUsecase.DeleteBlog(ctx, blogId.String(), entity.Precondition{})
+
+		assert.ErrorIs(t, err, entity.ErrPreconditionMissing)
+		assert.Equal(t, http.StatusPreconditionRequired, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "TrashBlog")
+	})
+
+	t.Run("stale if match", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(db.Blog{ID: blogId, Version: 2, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		err := blogUsecase.DeleteBlog(ctx, blogId.String(), current)
+
+		assert.ErrorIs(t, err, entity.ErrPreconditionFailed)
+		assert.Equal(t, http.StatusPreconditionFailed, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "TrashBlog")
+	})
+
+	t.Run("changed between the read and the delete", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(db.Blog{ID: blogId, Version: 1, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}, nil).Once()
+		mockStore.On("TrashBlog", ctx, db.TrashBlogParams{ID: blogId, Version: 1}).Return(int64(0), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		err := blogUsecase.DeleteBlog(ctx, blogId.String(), entity.Precondition{Any: true})
+
+		assert.ErrorIs(t, err, entity.ErrPreconditionFailed)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(db.Blog{}, sql.ErrNoRows).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		err := blogUsecase.DeleteBlog(ctx, blogId.String(), current)
+
+		assert.Equal(t, http.StatusNotFound, entity.GetStatusCode(err))
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("invalid id", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		err := blogUsecase.DeleteBlog(entity.ContextWithIdentity(context.Background(), testAuthor), "not-a-uuid", current)
+
+		assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "TrashBlog")
+	})
+
+	t.Run("reader is forbidden", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		err := blogUsecase.DeleteBlog(entity.ContextWithIdentity(context.Background(), testReader), blogId.String(), current)
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+		mockStore.AssertNotCalled(t, "GetBlog")
+	})
+
+	t.Run("author of another blog is forbidden", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(db.Blog{ID: blogId, AuthorID: uuid.NullUUID{UUID: uuid.New(), Valid: true}}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		err := blogUsecase.DeleteBlog(ctx, blogId.String(), current)
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+		mockStore.AssertNotCalled(t, "TrashBlog")
+	})
+
+	t.Run("anonymous caller is unauthorized", func(t *testing2.T) {
+		blogUsecase := NewBlogUseCase(new(mocks.Store), &config.Config{})
+
+		err := blogUsecase.DeleteBlog(context.Background(), blogId.String(), current)
+
+		assert.ErrorIs(t, err, entity.ErrUnauthorized)
+	})
+}
+
+func TestMockListingBlogsByCursor(t *testing2.T) {
+	cfg := &config.Config{Paginator: config.Paginator{CursorSecret: "secret"}}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	blogs := []db.Blog{
+		{ID: uuid.New(), CreatedAt: now},
+		{ID: uuid.New(), CreatedAt: now.Add(time.Second)},
+		{ID: uuid.New(), CreatedAt: now.Add(2 * time.Second)},
+	}
+
+	t.Run("first page", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("ListBlogAfter", ctx, db.ListBlogAfterParams{
+			ViewerID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true},
+			Limit:    3,
+		}).Return(blogs, nil).Once()
+		mockStore.On("CountBlogs", ctx, db.CountBlogsParams{
+			ViewerID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true},
+		}).Return(int64(3), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		res, err := blogUsecase.ListBlogs(ctx, intfaces.ListBlogsParams{Limit: "2", UseCursor: true})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Blog, 2)
+		assert.Empty(t, res.PrevCursor)
+		assert.Equal(t, int64(3), *res.TotalItems)
+		assert.Nil(t, res.TotalPages)
+
+		next, err := utils.DecodeCursor(cfg.Paginator.CursorSecret, res.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, blogs[1].ID, next.ID)
+		assert.True(t, blogs[1].CreatedAt.Equal(next.CreatedAt))
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("previous page", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		cursor := utils.EncodeCursor(cfg.Paginator.CursorSecret, utils.Cursor{
+			CreatedAt: blogs[2].CreatedAt,
+			ID:        blogs[2].ID,
+			Direction: utils.CursorPrevious,
+		})
+		mockStore.On("ListBlogBefore", ctx, mock.AnythingOfType("sqlc.ListBlogBeforeParams")).
+			Return([]db.Blog{blogs[1], blogs[0]}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		res, err := blogUsecase.ListBlogs(ctx, intfaces.ListBlogsParams{Limit: "2", UseCursor: true, Cursor: cursor, SkipCount: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []db.Blog{blogs[0], blogs[1]}, res.Blog)
+		assert.Empty(t, res.PrevCursor)
+		assert.NotEmpty(t, res.NextCursor)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("tampered cursor", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+		cursor := utils.EncodeCursor("another secret", utils.Cursor{ID: blogs[0].ID, Direction: utils.CursorNext})
+
+		_, err := blogUsecase.ListBlogs(entity.ContextWithIdentity(context.Background(), testReader), intfaces.ListBlogsParams{Limit: "2", UseCursor: true, Cursor: cursor})
+
+		assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+	})
+}
+
+func TestMockListingBlogsByAuthor(t *testing2.T) {
+	t.Run("filters by author", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		authorID := uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}
+		mockStore.On("ListBlog", ctx, mock.MatchedBy(func(args db.ListBlogParams) bool {
+			return args.AuthorID == authorID
+		})).Return([]db.Blog{{ID: uuid.New(), AuthorID: authorID}}, nil).Once()
+		mockStore.On("CountBlogs", ctx, mock.MatchedBy(func(args db.CountBlogsParams) bool {
+			return args.AuthorID == authorID
+		})).Return(int64(1), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		res, err := blogUsecase.ListBlogs(ctx, intfaces.ListBlogsParams{Page: "1", Limit: "10", AuthorID: testAuthor.UserID.String()})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Blog, 1)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("invalid author", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.ListBlogs(entity.ContextWithIdentity(context.Background(), testReader), intfaces.ListBlogsParams{Page: "1", Limit: "10", AuthorID: "not-a-uuid"})
+
+		assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "ListBlog", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMockListingBlogsPageMeta(t *testing2.T) {
+	t.Run("middle page with totals", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("ListBlog", ctx, mock.MatchedBy(func(args db.ListBlogParams) bool {
+			return args.Limit == 3 && args.Offset == 2
+		})).Return([]db.Blog{{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()}}, nil).Once()
+		mockStore.On("CountBlogs", ctx, mock.AnythingOfType("sqlc.CountBlogsParams")).Return(int64(5), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		res, err := blogUsecase.ListBlogs(ctx, intfaces.ListBlogsParams{Page: "2", Limit: "2"})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Blog, 2)
+		assert.Equal(t, int32(2), res.CurrentPage)
+		assert.True(t, res.HasNext)
+		assert.Equal(t, int64(5), *res.TotalItems)
+		assert.Equal(t, int64(3), *res.TotalPages)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("count skipped", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("ListBlog", ctx, mock.AnythingOfType("sqlc.ListBlogParams")).Return([]db.Blog{{ID: uuid.New()}}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		res, err := blogUsecase.ListBlogs(ctx, intfaces.ListBlogsParams{Page: "1", Limit: "2", SkipCount: true})
+
+		assert.NoError(t, err)
+		assert.False(t, res.HasNext)
+		assert.Nil(t, res.TotalItems)
+		assert.Nil(t, res.TotalPages)
+		mockStore.AssertNotCalled(t, "CountBlogs", mock.Anything, mock.Anything)
+	})
+
+	for name, args := range map[string]intfaces.ListBlogsParams{
+		"page is not a number": {Page: "abc", Limit: "10"},
+		"page zero":            {Page: "0", Limit: "10"},
+		"negative page size":   {Page: "1", Limit: "-5"},
+		"page size overflow":   {Page: "1", Limit: "99999999999"},
+	} {
+		t.Run(name, func(t *testing2.T) {
+			mockStore := new(mocks.Store)
+			blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+			_, err := blogUsecase.ListBlogs(entity.ContextWithIdentity(context.Background(), testReader), args)
+
+			assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+			mockStore.AssertNotCalled(t, "ListBlog", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestMockSearchingBlogs(t *testing2.T) {
+	t.Run("phrase and prefix query", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("SearchBlogs", ctx, db.SearchBlogsParams{
+			Query:    "(clean <-> architecture) & go:*",
+			ViewerID: uuid.NullUUID{UUID: testReader.UserID, Valid: true},
+			Limit:    3,
+			Offset:   0,
+		}).Return([]db.SearchBlogsRow{{Rank: 0.9}, {Rank: 0.5}, {Rank: 0.1}}, nil).Once()
+		mockStore.On("CountSearchBlogs", ctx, db.CountSearchBlogsParams{
+			Query:    "(clean <-> architecture) & go:*",
+			ViewerID: uuid.NullUUID{UUID: testReader.UserID, Valid: true},
+		}).Return(int64(3), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		res, err := blogUsecase.SearchBlogs(ctx, intfaces.SearchBlogsParams{Query: `"clean architecture" go*`, Page: "1", Limit: "2"})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Blog, 2)
+		assert.NotEmpty(t, res.NextPage)
+		assert.True(t, res.HasNext)
+		assert.Equal(t, int64(2), *res.TotalPages)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("empty query", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.SearchBlogs(entity.ContextWithIdentity(context.Background(), testReader), intfaces.SearchBlogsParams{Query: " && ", Page: "1", Limit: "10"})
+
+		assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "SearchBlogs", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMockListingBlogsQueryLanguage(t *testing2.T) {
+	t.Run("sort and filters are bound to the query", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("ListBlog", ctx, mock.MatchedBy(func(args db.ListBlogParams) bool {
+			return args.Sort1 == "-created_at" && args.Sort2 == "title" && args.Sort3 == "" &&
+				args.Status == sql.NullString{String: "published", Valid: true} &&
+				args.CreatedAfter.Valid && args.CreatedAfter.Time.Equal(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC))
+		})).Return([]db.Blog{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		res, err := blogUsecase.ListBlogs(ctx, intfaces.ListBlogsParams{
+			Page:      "1",
+			Limit:     "10",
+			Sort:      "-created_at,title",
+			Filters:   map[string]string{"status": "published", "created_after": "2023-01-02"},
+			Fields:    "id,created_at",
+			SkipCount: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"id", "createdAt"}, res.Fields)
+		mockStore.AssertExpectations(t)
+	})
+
+	for name, args := range map[string]intfaces.ListBlogsParams{
+		"unknown sort field":   {Sort: "descriptions"},
+		"unknown filter":       {Filters: map[string]string{"author_id": "1"}},
+		"unknown status":       {Filters: map[string]string{"status": "deleted"}},
+		"invalid time":         {Filters: map[string]string{"created_before": "yesterday"}},
+		"unknown field":        {Fields: "id,password_hash"},
+		"cursor with sort":     {Sort: "title", UseCursor: true},
+		"too many sort fields": {Sort: "title,created_at,updated_at,published_at"},
+		"unknown tag match":    {Tags: []string{"go"}, TagMatch: "some"},
+	} {
+		t.Run(name, func(t *testing2.T) {
+			mockStore := new(mocks.Store)
+			blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+			args.Page, args.Limit = "1", "10"
+
+			_, err := blogUsecase.ListBlogs(entity.ContextWithIdentity(context.Background(), testReader), args)
+
+			assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+			mockStore.AssertNotCalled(t, "ListBlog", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestMockTrashingBlogs(t *testing2.T) {
+	blogId := uuid.New()
+	trashed := db.Blog{ID: blogId, Version: 2, DeletedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+
+	t.Run("admin lists the trash", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAdmin)
+		mockStore.On("ListTrashedBlogs", ctx, db.ListTrashedBlogsParams{Limit: 3, Offset: 0}).Return([]db.Blog{trashed}, nil).Once()
+		mockStore.On("CountTrashedBlogs", ctx, uuid.NullUUID{}).Return(int64(1), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		res, err := blogUsecase.ListTrashedBlogs(ctx, intfaces.ListTrashedBlogsParams{Page: "1", Limit: "2"})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Blog, 1)
+		assert.Equal(t, int64(1), *res.TotalItems)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("author can not list the trash", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.ListTrashedBlogs(entity.ContextWithIdentity(context.Background(), testAuthor), intfaces.ListTrashedBlogsParams{Page: "1", Limit: "10"})
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+		mockStore.AssertNotCalled(t, "ListTrashedBlogs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("admin restores a blog", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAdmin)
+		mockStore.On("RestoreBlog", ctx, blogId).Return(db.Blog{ID: blogId, Version: 3}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		blog, err := blogUsecase.RestoreBlog(ctx, blogId.String())
+
+		assert.NoError(t, err)
+		assert.False(t, blog.DeletedAt.Valid)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("restoring a blog not in the trash", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAdmin)
+		mockStore.On("RestoreBlog", ctx, blogId).Return(db.Blog{}, sql.ErrNoRows).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.RestoreBlog(ctx, blogId.String())
+
+		assert.Equal(t, http.StatusNotFound, entity.GetStatusCode(err))
+	})
+
+	t.Run("purge keeps the blogs within the retention", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := context.Background()
+		mockStore.On("PurgeTrashedBlogs", ctx, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before).Round(time.Hour) == 30*24*time.Hour
+		})).Return(int64(4), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{Trash: config.Trash{RetentionDays: 30}})
+
+		purged, err := blogUsecase.PurgeTrashedBlogs(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), purged)
+		mockStore.AssertExpectations(t)
+	})
+}
+
+func TestMockBlogRevisions(t *testing2.T) {
+	blogId := uuid.New()
+	owned := db.Blog{ID: blogId, Title: "Draft", Body: "one\ntwo", Version: 2, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}
+	current := entity.Precondition{ETags: []string{entity.ETag(blogId, 2)}}
+	first := db.BlogRevision{BlogID: blogId, Revision: 1, Title: "Draft", Body: "one\ntwo"}
+	second := db.BlogRevision{BlogID: blogId, Revision: 2, Title: "Draft", Body: "one\n2\nthree"}
+
+	t.Run("update records a revision", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		updated := owned
+		updated.Title, updated.Version = "Final", 3
+		mockStore.On("GetBlog", ctx, blogId).Return(owned, nil).Once()
+		inTx(mockStore, ctx)
+		mockStore.On("UpdateBlog", ctx, mock.AnythingOfType("sqlc.UpdateBlogParams")).Return(updated, nil).Once()
+		mockStore.On("CreateBlogRevision", ctx, mock.MatchedBy(func(args db.CreateBlogRevisionParams) bool {
+			return args.Revision == 3 && args.Title == "Final" && args.EditorID.UUID == testAuthor.UserID
+		})).Return(db.BlogRevision{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.UpdateBlog(ctx, blogId.String(), intfaces.UpdateBlogParams{Title: "Final"}, current)
+
+		assert.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("empty summary is cleared to null", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned, nil).Once()
+		inTx(mockStore, ctx)
+		mockStore.On("PatchBlog", ctx, db.PatchBlogParams{SetSummary: true, ID: blogId, Version: 2}).Return(owned, nil).Once()
+		mockStore.On("CreateBlogRevision", ctx, mock.Anything).Return(db.BlogRevision{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+		summary := ""
+
+		_, err := blogUsecase.PatchBlog(ctx, blogId.String(), intfaces.PatchBlogParams{Summary: &summary}, current)
+
+		assert.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("failed revision fails the update", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned, nil).Once()
+		inTx(mockStore, ctx)
+		mockStore.On("PatchBlog", ctx, mock.AnythingOfType("sqlc.PatchBlogParams")).Return(owned, nil).Once()
+		mockStore.On("CreateBlogRevision", ctx, mock.Anything).Return(db.BlogRevision{}, sql.ErrConnDone).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.PatchBlog(ctx, blogId.String(), intfaces.PatchBlogParams{}, current)
+
+		assert.Equal(t, http.StatusInternalServerError, entity.GetStatusCode(err))
+	})
+
+	t.Run("diff lists the changed fields line by line", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned, nil).Once()
+		mockStore.On("GetBlogRevision", ctx, db.GetBlogRevisionParams{BlogID: blogId, Revision: 1}).Return(first, nil).Once()
+		mockStore.On("GetBlogRevision", ctx, db.GetBlogRevisionParams{BlogID: blogId, Revision: 2}).Return(second, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		diff, err := blogUsecase.DiffBlogRevisions(ctx, blogId.String(), "1", "2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []intfaces.BlogFieldDiff{{Field: "body", Lines: []entity.DiffLine{
+			{Op: entity.DiffEqual, Text: "one", OldLine: 1, NewLine: 1},
+			{Op: entity.DiffDelete, Text: "two", OldLine: 2},
+			{Op: entity.DiffInsert, Text: "2", NewLine: 2},
+			{Op: entity.DiffInsert, Text: "three", NewLine: 3},
+		}}}, diff.Changes)
+	})
+
+	t.Run("unknown revision", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned, nil).Once()
+		mockStore.On("GetBlogRevision", ctx, db.GetBlogRevisionParams{BlogID: blogId, Revision: 9}).Return(db.BlogRevision{}, sql.ErrNoRows).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.GetBlogRevision(ctx, blogId.String(), "9")
+
+		assert.Equal(t, http.StatusNotFound, entity.GetStatusCode(err))
+	})
+
+	t.Run("restore writes the old content as a new revision", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned, nil).Once()
+		mockStore.On("GetBlogRevision", ctx, db.GetBlogRevisionParams{BlogID: blogId, Revision: 1}).Return(first, nil).Once()
+		inTx(mockStore, ctx)
+		mockStore.On("UpdateBlog", ctx, db.UpdateBlogParams{ID: blogId, Title: "Draft", Body: "one\ntwo", Version: 2}).
+			Return(db.Blog{ID: blogId, Title: "Draft", Body: "one\ntwo", Version: 3}, nil).Once()
+		mockStore.On("CreateBlogRevision", ctx, mock.MatchedBy(func(args db.CreateBlogRevisionParams) bool {
+			return args.Revision == 3 && args.Body == "one\ntwo"
+		})).Return(db.BlogRevision{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		blog, err := blogUsecase.RestoreBlogRevision(ctx, blogId.String(), "1", current)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), blog.Version)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("only the author sees the history", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.ListBlogRevisions(entity.ContextWithIdentity(context.Background(), testReader), blogId.String(), intfaces.ListBlogRevisionsParams{Page: "1", Limit: "10"})
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+		mockStore.AssertNotCalled(t, "ListBlogRevisions", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMockBlogTags(t *testing2.T) {
+	blogId := uuid.New()
+	owned := db.Blog{ID: blogId, Title: "Draft", Version: 2, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}
+	current := entity.Precondition{ETags: []string{entity.ETag(blogId, 2)}}
+
+	t.Run("create tags the blog once per slug", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		created := db.Blog{ID: blogId, Title: "Draft", Slug: "draft", Version: 1}
+		inTx(mockStore, ctx)
+		mockStore.On("CreateBlog", ctx, mock.AnythingOfType("sqlc.CreateBlogParams")).Return(created, nil).Once()
+		mockStore.On("DeleteBlogTags", ctx, blogId).Return(nil).Once()
+		mockStore.On("UpsertTags", ctx, db.UpsertTagsParams{Names: []string{"Go", "PostgreSQL"}, Slugs: []string{"go", "postgresql"}}).
+			Return([]db.Tag{}, nil).Once()
+		mockStore.On("AddBlogTags", ctx, db.AddBlogTagsParams{BlogID: blogId, Slugs: []string{"go", "postgresql"}}).Return(nil).Once()
+		mockStore.On("CreateBlogRevision", ctx, mock.Anything).Return(db.BlogRevision{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.CreateBlog(ctx, intfaces.CreateBlogParams{Title: "Draft", Tags: []string{" Go ", "PostgreSQL", "go"}})
+
+		assert.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("an empty list removes the tags", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned, nil).Once()
+		inTx(mockStore, ctx)
+		mockStore.On("PatchBlog", ctx, mock.AnythingOfType("sqlc.PatchBlogParams")).Return(owned, nil).Once()
+		mockStore.On("DeleteBlogTags", ctx, blogId).Return(nil).Once()
+		mockStore.On("CreateBlogRevision", ctx, mock.Anything).Return(db.BlogRevision{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.PatchBlog(ctx, blogId.String(), intfaces.PatchBlogParams{Tags: []string{}}, current)
+
+		assert.NoError(t, err)
+		mockStore.AssertExpectations(t)
+		mockStore.AssertNotCalled(t, "UpsertTags", mock.Anything, mock.Anything)
+	})
+
+	t.Run("too many tags", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetBlog", ctx, blogId).Return(owned, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+		tags := strings.Split("a,b,c,d,e,f,g,h,i,j,k", ",")
+
+		_, err := blogUsecase.UpdateBlog(ctx, blogId.String(), intfaces.UpdateBlogParams{Title: "Draft", Tags: tags}, current)
+
+		assert.Equal(t, entity.InvalidFields(entity.FieldError{Field: "tags", In: "body", Code: "too_many", Message: "must have at most 10 items"}), err)
+		mockStore.AssertNotCalled(t, "WithTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("listing filtered by a tag without a letter", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.ListBlogs(entity.ContextWithIdentity(context.Background(), testReader), intfaces.ListBlogsParams{
+			Page:  "1",
+			Limit: "10",
+			Tags:  []string{"go", "#!"},
+		})
+
+		assert.Equal(t, entity.InvalidFields(entity.FieldError{
+			Field:   "tag[1]",
+			In:      "query",
+			Code:    "invalid_tag",
+			Message: "must be at most 50 characters long with a letter or a digit",
+		}), err)
+		mockStore.AssertNotCalled(t, "ListBlog", mock.Anything, mock.Anything)
+	})
+
+	t.Run("listing filtered by all of the tags", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("ListBlog", ctx, mock.MatchedBy(func(args db.ListBlogParams) bool {
+			return slices.Equal(args.Tags, []string{"go", "postgres"}) && args.MatchAllTags
+		})).Return([]db.Blog{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		_, err := blogUsecase.ListBlogs(ctx, intfaces.ListBlogsParams{
+			Page:      "1",
+			Limit:     "10",
+			Tags:      []string{"Go", "postgres"},
+			TagMatch:  "all",
+			SkipCount: true,
+		})
+
+		assert.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("tags with usage counts", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		tags := []db.ListTagsRow{{Name: "Go", Slug: "go", UsageCount: 3}, {Name: "SQL", Slug: "sql", UsageCount: 0}}
+		mockStore.On("ListTags", ctx, db.ListTagsParams{Limit: 11, Offset: 0}).Return(tags, nil).Once()
+		mockStore.On("CountTags", ctx).Return(int64(2), nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, &config.Config{})
+
+		res, err := blogUsecase.ListTags(ctx, intfaces.ListTagsParams{Page: "1", Limit: "10"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, tags, res.Tags)
+		assert.Equal(t, int64(2), *res.TotalItems)
+	})
+}
+
+func TestMockBlogActivity(t *testing2.T) {
+	published := db.Blog{ID: uuid.New(), Status: db.BlogStatusPublished}
+	cfg := &config.Config{Activity: config.Activity{ViewShards: 4, PopularHalfLife: 24 * time.Hour, PopularWindow: 7 * 24 * time.Hour}}
+
+	t.Run("concurrent views are flushed once each", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("GetBlog", ctx, published.ID).Return(published, nil)
+		mockStore.On("SumBlogViews", ctx, published.ID).Return(int64(40), nil)
+		mockStore.On("CountBlogReactions", ctx, published.ID).Return([]db.CountBlogReactionsRow{}, nil)
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := blogUsecase.ViewBlog(ctx, published.ID.String())
+				assert.NoError(t, err)
+			}()
+		}
+
+		wg.Wait()
+
+		view, err := blogUsecase.ViewBlog(ctx, published.ID.String())
+
+		// The stats are read before the view of the fetch is counted -.
+		assert.NoError(t, err)
+		assert.Equal(t, int64(40+50), view.Stats.Views)
+
+		mockStore.On("AddBlogViews", context.Background(), mock.MatchedBy(func(args db.AddBlogViewsParams) bool {
+			return slices.Equal(args.BlogIds, []uuid.UUID{published.ID}) && slices.Equal(args.Views, []int64{51}) &&
+				args.Shard >= 0 && args.Shard < 4 && args.Bucket.Equal(args.Bucket.Truncate(time.Hour))
+		})).Return(nil).Once()
+
+		flushed, err := blogUsecase.FlushBlogViews(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(51), flushed)
+
+		flushed, err = blogUsecase.FlushBlogViews(context.Background())
+
+		assert.NoError(t, err)
+		assert.Zero(t, flushed)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("views are flushed in the order of the blogs", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		blogUsecase := NewBlogUseCase(mockStore, cfg).(*BlogUseCase)
+		ids := []uuid.UUID{
+			uuid.MustParse("f0000000-0000-0000-0000-000000000000"),
+			uuid.MustParse("0f000000-0000-0000-0000-000000000000"),
+			uuid.MustParse("a0000000-0000-0000-0000-000000000000"),
+		}
+
+		for i, id := range ids {
+			blogUsecase.views.add(id, int64(i+1))
+		}
+
+		mockStore.On("AddBlogViews", context.Background(), mock.MatchedBy(func(args db.AddBlogViewsParams) bool {
+			return slices.Equal(args.BlogIds, []uuid.UUID{ids[1], ids[2], ids[0]}) && slices.Equal(args.Views, []int64{2, 3, 1})
+		})).Return(nil).Once()
+
+		flushed, err := blogUsecase.FlushBlogViews(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(6), flushed)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("failed flush keeps the views", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("GetBlog", ctx, published.ID).Return(published, nil)
+		mockStore.On("SumBlogViews", ctx, published.ID).Return(int64(0), nil)
+		mockStore.On("CountBlogReactions", ctx, published.ID).Return([]db.CountBlogReactionsRow{}, nil)
+		mockStore.On("AddBlogViews", context.Background(), mock.Anything).Return(sql.ErrConnDone).Once()
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		_, err := blogUsecase.ViewBlog(ctx, published.ID.String())
+		assert.NoError(t, err)
+
+		_, err = blogUsecase.FlushBlogViews(context.Background())
+		assert.Equal(t, http.StatusInternalServerError, entity.GetStatusCode(err))
+
+		view, err := blogUsecase.ViewBlog(ctx, published.ID.String())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), view.Stats.Views)
+	})
+
+	t.Run("drafts are not counted", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		draft := db.Blog{ID: uuid.New(), Status: db.BlogStatusDraft, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}
+		mockStore.On("GetBlog", ctx, draft.ID).Return(draft, nil).Once()
+		mockStore.On("SumBlogViews", ctx, draft.ID).Return(int64(0), nil).Once()
+		mockStore.On("CountBlogReactions", ctx, draft.ID).Return([]db.CountBlogReactionsRow{}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		view, err := blogUsecase.ViewBlog(ctx, draft.ID.String())
+
+		assert.NoError(t, err)
+		assert.Zero(t, view.Stats.Views)
+	})
+
+	t.Run("reacting again replaces the reaction", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("GetBlog", ctx, published.ID).Return(published, nil).Once()
+		mockStore.On("UpsertBlogReaction", ctx, db.UpsertBlogReactionParams{BlogID: published.ID, UserID: testReader.UserID, Kind: db.ReactionKindLove}).
+			Return(db.BlogReaction{}, nil).Once()
+		mockStore.On("SumBlogViews", ctx, published.ID).Return(int64(9), nil).Once()
+		mockStore.On("CountBlogReactions", ctx, published.ID).
+			Return([]db.CountBlogReactionsRow{{Kind: db.ReactionKindLike, Count: 3}, {Kind: db.ReactionKindLove, Count: 1}}, nil).Once()
+		mockStore.On("GetBlogReaction", ctx, db.GetBlogReactionParams{BlogID: published.ID, UserID: testReader.UserID}).
+			Return(db.BlogReaction{Kind: db.ReactionKindLove}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		stats, err := blogUsecase.ReactToBlog(ctx, published.ID.String(), "love")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[db.ReactionKind]int64{db.ReactionKindLike: 3, db.ReactionKindLove: 1}, stats.Reactions)
+		assert.Equal(t, db.ReactionKindLove, stats.MyReaction)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("unknown reaction", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		_, err := blogUsecase.ReactToBlog(ctx, published.ID.String(), "angry")
+
+		assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "GetBlog", mock.Anything, mock.Anything)
+	})
+
+	t.Run("admins can not react", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAdmin)
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		_, err := blogUsecase.ReactToBlog(ctx, published.ID.String(), "like")
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+	})
+
+	t.Run("popular blogs decay over the window", func(t *testing2.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("ListPopularBlogs", ctx, mock.MatchedBy(func(args db.ListPopularBlogsParams) bool {
+			return args.HalfLifeSeconds == 86400 && args.ReactionWeight == _reactionWeight && args.Limit == 3 && args.Offset == 2 &&
+				time.Since(args.Since) >= 7*24*time.Hour
+		})).Return([]db.ListPopularBlogsRow{{Score: 3}, {Score: 2}, {Score: 1}}, nil).Once()
+		blogUsecase := NewBlogUseCase(mockStore, cfg)
+
+		res, err := blogUsecase.ListPopularBlogs(ctx, intfaces.ListPopularBlogsParams{Page: "2", Limit: "2"})
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Blogs, 2)
+		assert.True(t, res.HasNext)
+		assert.Nil(t, res.TotalItems)
+		mockStore.AssertExpectations(t)
+	})
+}
+
+func TestTracingBlogUsecase(t *testing2.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider, err := tracing.New(tracing.SpanExporter(exporter))
+	assert.NoError(t, err)
+
+	defer provider.Shutdown(context.Background())
+
+	t.Run("the queries are children of the method span", func(t *testing2.T) {
+		exporter.Reset()
+		mockUsecase := new(mocks.BlogUsecase)
+		ctx, parent := tracing.Tracer().Start(context.Background(), "GET /api/v1/blogs")
+		mockUsecase.On("ListBlogs", mock.MatchedBy(func(ctx context.Context) bool {
+			return trace.SpanContextFromContext(ctx).TraceID() == parent.SpanContext().TraceID()
+		}), intfaces.ListBlogsParams{Page: "1", Limit: "10"}).Return(&intfaces.ListBlogsResponse{}, nil).Once()
+
+		_, err := NewTracedBlogUseCase(mockUsecase).ListBlogs(ctx, intfaces.ListBlogsParams{Page: "1", Limit: "10"})
+		parent.End()
+
+		assert.NoError(t, err)
+		mockUsecase.AssertExpectations(t)
+
+		spans := exporter.GetSpans()
+
+		if assert.Len(t, spans, 2) {
+			assert.Equal(t, "BlogUseCase.ListBlogs", spans[0].Name)
+			assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+		}
+	})
+
+	t.Run("only server errors fail the span", func(t *testing2.T) {
+		exporter.Reset()
+		mockUsecase := new(mocks.BlogUsecase)
+		mockUsecase.On("GetBlog", mock.Anything, "missing").Return(nil, entity.ErrNotFound).Once()
+		mockUsecase.On("GetBlog", mock.Anything, "broken").Return(nil, entity.ErrInternalServerError).Once()
+		blogUsecase := NewTracedBlogUseCase(mockUsecase)
+
+		_, _ = blogUsecase.GetBlog(context.Background(), "missing")
+		_, _ = blogUsecase.GetBlog(context.Background(), "broken")
+
+		spans := exporter.GetSpans()
+
+		if assert.Len(t, spans, 2) {
+			assert.Equal(t, codes.Unset, spans[0].Status.Code)
+			assert.Len(t, spans[0].Events, 1)
+			assert.Equal(t, codes.Error, spans[1].Status.Code)
+		}
+	})
 }
@@ -0,0 +1,22 @@
+package comment_usecase
+
+import (
+	"github.com/harmannkibue/golang_gin_clean_architecture/config"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"time"
+)
+
+type CommentUseCase struct {
+	config *config.Config
+	store  intfaces.Store
+	// now is swapped in tests to control the edit window -.
+	now func() time.Time
+}
+
+func NewCommentUseCase(store intfaces.Store, config *config.Config) intfaces.IntCommentUsecase {
+	return &CommentUseCase{
+		store:  store,
+		config: config,
+		now:    time.Now,
+	}
+}
@@ -0,0 +1,360 @@
+package comment_usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/utils"
+	"strings"
+)
+
+var errMissingBody = entity.ErrBadRequest.WithMessage("enter a body for the comment")
+
+// CreateComment posts a comment on a blog, or a reply when a parent comment is passed -.
+func (usecase *CommentUseCase) CreateComment(ctx context.Context, blogID string, args intfaces.CreateCommentParams) (*sqlc.Comment, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionCommentCreate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(args.Body)
+
+	if body == "" {
+		return nil, errMissingBody
+	}
+
+	uuID, err := parseBlogID(blogID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.commentedBlog(ctx, identity, uuID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params := sqlc.CreateCommentParams{
+		BlogID:   blog.ID,
+		AuthorID: uuid.NullUUID{UUID: identity.UserID, Valid: true},
+		Body:     body,
+	}
+
+	if args.ParentID != "" {
+		parent, err := usecase.parentComment(ctx, blog.ID, args.ParentID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		params.ParentID = uuid.NullUUID{UUID: parent.ID, Valid: true}
+		params.Depth = parent.Depth + 1
+		params.ParentPath = parent.Path
+	}
+
+	comment, err := usecase.store.CreateComment(ctx, params)
+
+	if err != nil {
+		return nil, commentStoreError("uc.usecase.CreateComment", err)
+	}
+
+	return &comment, nil
+}
+
+// GetComment getting a single comment by id, deleted comments are returned without their content -.
+func (usecase *CommentUseCase) GetComment(ctx context.Context, id string) (*sqlc.Comment, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionCommentRead)
+
+	if err != nil {
+		return nil, err
+	}
+
+	uuID, err := parseCommentID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := usecase.store.GetComment(ctx, uuID)
+
+	if err != nil {
+		return nil, commentStoreError("uc.usecase.GetComment", err)
+	}
+
+	if _, err = usecase.commentedBlog(ctx, identity, comment.BlogID); err != nil {
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+// ListBlogComments lists the comments of a blog depth first -.
+func (usecase *CommentUseCase) ListBlogComments(ctx context.Context, blogID string, args intfaces.ListCommentsParams) (*intfaces.ListCommentsResponse, error) {
+	identity, err := entity.Authorize(ctx, entity.PermissionCommentRead)
+
+	if err != nil {
+		return nil, err
+	}
+
+	uuID, err := parseBlogID(blogID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := usecase.commentedBlog(ctx, identity, uuID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.listThread(ctx, blog.ID, "", args)
+}
+
+// ListCommentThread lists a comment and the replies under it depth first -.
+func (usecase *CommentUseCase) ListCommentThread(ctx context.Context, id string, args intfaces.ListCommentsParams) (*intfaces.ListCommentsResponse, error) {
+	comment, err := usecase.GetComment(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The path of every reply under the comment starts with the path of the comment -.
+	return usecase.listThread(ctx, comment.BlogID, comment.Path, args)
+}
+
+// EditComment changes the body of a comment within the edit window -.
+func (usecase *CommentUseCase) EditComment(ctx context.Context, id string, body string) (*sqlc.Comment, error) {
+	// Checking the role first spares the database lookup for callers who can never edit a comment -.
+	if _, err := entity.Authorize(ctx, entity.PermissionCommentUpdate); err != nil {
+		return nil, err
+	}
+
+	body = strings.TrimSpace(body)
+
+	if body == "" {
+		return nil, errMissingBody
+	}
+
+	comment, err := usecase.liveComment(ctx, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = entity.AuthorizeOwner(ctx, entity.PermissionCommentUpdate, comment.AuthorID); err != nil {
+		return nil, err
+	}
+
+	window := usecase.config.Comments.EditWindow
+	postedAfter := usecase.now().Add(-window)
+
+	if !comment.CreatedAt.After(postedAfter) {
+		return nil, entity.ErrForbidden.WithMessage(fmt.Sprintf("comments can only be edited within %s of being posted", window))
+	}
+
+	edited, err := usecase.store.EditComment(ctx, sqlc.EditCommentParams{Body: body, ID: comment.ID, PostedAfter: postedAfter})
+
+	// No rows means the comment was deleted or its window closed since it was read -.
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, entity.ErrConflict.WithMessage("the comment can no longer be edited").Wrap(err)
+	}
+
+	if err != nil {
+		return nil, commentStoreError("uc.usecase.EditComment", err)
+	}
+
+	return &edited, nil
+}
+
+// DeleteComment removes the content of a comment and keeps its place in the thread -.
+func (usecase *CommentUseCase) DeleteComment(ctx context.Context, id string) error {
+	// Moderators delete the comments of anyone, everyone else only their own -.
+	permission := entity.PermissionCommentDelete
+
+	if identity, ok := entity.IdentityFromContext(ctx); ok && identity.Can(entity.PermissionCommentModerate) {
+		permission = entity.PermissionCommentModerate
+	}
+
+	if _, err := entity.Authorize(ctx, permission); err != nil {
+		return err
+	}
+
+	comment, err := usecase.liveComment(ctx, id)
+
+	if err != nil {
+		return err
+	}
+
+	if permission == entity.PermissionCommentDelete {
+		if _, err = entity.AuthorizeOwner(ctx, permission, comment.AuthorID); err != nil {
+			return err
+		}
+	}
+
+	deleted, err := usecase.store.DeleteComment(ctx, comment.ID)
+
+	if err != nil {
+		return commentStoreError("uc.usecase.DeleteComment", err)
+	}
+
+	if deleted == 0 {
+		return commentStoreError("uc.usecase.DeleteComment", sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// listThread pages through the comments of a blog under the path prefix -.
+func (usecase *CommentUseCase) listThread(ctx context.Context, blogID uuid.UUID, pathPrefix string, args intfaces.ListCommentsParams) (*intfaces.ListCommentsResponse, error) {
+	paginator, err := utils.ParsePaginator(args.Page, args.Limit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	Limit, Offset := paginator.Params()
+
+	comments, err := usecase.store.ListCommentThread(ctx, sqlc.ListCommentThreadParams{
+		BlogID:     blogID,
+		PathPrefix: pathPrefix,
+		Limit:      Limit,
+		Offset:     Offset,
+	})
+
+	if err != nil {
+		return nil, commentStoreError("uc.usecase.listThread", err)
+	}
+
+	comments, nextPage, previousPage, meta := utils.PaginatorPage(ctx, paginator, comments)
+
+	response := &intfaces.ListCommentsResponse{
+		Comments:     comments,
+		NextPage:     nextPage,
+		PreviousPage: previousPage,
+		PageMeta:     meta,
+	}
+
+	if args.SkipCount {
+		return response, nil
+	}
+
+	total, err := usecase.store.CountCommentThread(ctx, sqlc.CountCommentThreadParams{BlogID: blogID, PathPrefix: pathPrefix})
+
+	if err != nil {
+		return nil, commentStoreError("uc.usecase.listThread", err)
+	}
+
+	utils.SetPageTotals(&response.PageMeta, total)
+
+	return response, nil
+}
+
+// commentedBlog fetches the blog the comments are on, the comments of a blog that is not published are only seen by
+// its author. Not found is returned rather than forbidden so that unpublished blogs can not be discovered -.
+func (usecase *CommentUseCase) commentedBlog(ctx context.Context, identity *entity.Identity, blogID uuid.UUID) (*sqlc.Blog, error) {
+	blog, err := usecase.store.GetBlog(ctx, blogID)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, entity.ErrNotFound.WithMessage("blog not found").Wrap(err)
+	}
+
+	if err != nil {
+		return nil, commentStoreError("uc.usecase.commentedBlog", err)
+	}
+
+	if blog.Status != sqlc.BlogStatusPublished && (!blog.AuthorID.Valid || blog.AuthorID.UUID != identity.UserID) {
+		return nil, entity.ErrNotFound.WithMessage("blog not found")
+	}
+
+	return &blog, nil
+}
+
+// parentComment fetches the comment a reply is posted to, it must be on the same blog, not deleted and not at the
+// deepest level already -.
+func (usecase *CommentUseCase) parentComment(ctx context.Context, blogID uuid.UUID, id string) (*sqlc.Comment, error) {
+	uuID, err := uuid.Parse(id)
+
+	if err != nil {
+		return nil, entity.ErrBadRequest.WithMessage("invalid parent comment id").Wrap(err)
+	}
+
+	parent, err := usecase.store.GetComment(ctx, uuID)
+
+	if err != nil {
+		return nil, commentStoreError("uc.usecase.parentComment", err)
+	}
+
+	if parent.BlogID != blogID {
+		return nil, entity.ErrNotFound.WithMessage("comment not found")
+	}
+
+	if parent.DeletedAt.Valid {
+		return nil, entity.ErrConflict.WithMessage("deleted comments can not be replied to")
+	}
+
+	if maxDepth := usecase.config.Comments.MaxDepth; parent.Depth >= maxDepth {
+		return nil, entity.ErrBadRequest.WithMessage(fmt.Sprintf("replies nest at most %d deep, reply higher up the thread", maxDepth))
+	}
+
+	return &parent, nil
+}
+
+// liveComment fetches a comment that is about to be changed, deleted comments are not found -.
+func (usecase *CommentUseCase) liveComment(ctx context.Context, id string) (*sqlc.Comment, error) {
+	uuID, err := parseCommentID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := usecase.store.GetComment(ctx, uuID)
+
+	if err != nil {
+		return nil, commentStoreError("uc.usecase.liveComment", err)
+	}
+
+	if comment.DeletedAt.Valid {
+		return nil, entity.ErrNotFound.WithMessage("comment not found")
+	}
+
+	return &comment, nil
+}
+
+// parseBlogID converts the blog id into a uuid, invalid ids are a bad request -.
+func parseBlogID(id string) (uuid.UUID, error) {
+	uuID, err := uuid.Parse(id)
+
+	if err != nil {
+		return uuid.Nil, entity.ErrBadRequest.WithMessage("invalid blog id").Wrap(err)
+	}
+
+	return uuID, nil
+}
+
+// parseCommentID converts the comment id into a uuid, invalid ids are a bad request -.
+func parseCommentID(id string) (uuid.UUID, error) {
+	uuID, err := uuid.Parse(id)
+
+	if err != nil {
+		return uuid.Nil, entity.ErrBadRequest.WithMessage("invalid comment id").Wrap(err)
+	}
+
+	return uuID, nil
+}
+
+// commentStoreError maps repository errors to the entity errors -.
+func commentStoreError(op string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.ErrNotFound.WithMessage("comment not found").Wrap(err)
+	}
+
+	return entity.ErrInternalServerError.Wrap(fmt.Errorf("IntCommentUsecase - %s: %w", op, err))
+}
@@ -0,0 +1,198 @@
+package comment_usecase
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/config"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/mocks"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var (
+	testAuthor = &entity.Identity{UserID: uuid.New(), Role: entity.RoleAuthor}
+	testReader = &entity.Identity{UserID: uuid.New(), Role: entity.RoleReader}
+	testAdmin  = &entity.Identity{UserID: uuid.New(), Role: entity.RoleAdmin}
+)
+
+func testUseCase(store intfaces.Store, now time.Time) *CommentUseCase {
+	return &CommentUseCase{
+		store:  store,
+		config: &config.Config{Comments: config.Comments{EditWindow: 15 * time.Minute, MaxDepth: 2}},
+		now:    func() time.Time { return now },
+	}
+}
+
+func TestMockCreatingComment(t *testing.T) {
+	now := time.Now()
+	blog := sqlc.Blog{ID: uuid.New(), Status: sqlc.BlogStatusPublished, AuthorID: uuid.NullUUID{UUID: testAuthor.UserID, Valid: true}}
+	parent := sqlc.Comment{ID: uuid.New(), BlogID: blog.ID, Depth: 1, Path: "000000000001.000000000002."}
+
+	t.Run("reply goes under its parent", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("GetBlog", ctx, blog.ID).Return(blog, nil).Once()
+		mockStore.On("GetComment", ctx, parent.ID).Return(parent, nil).Once()
+		mockStore.On("CreateComment", ctx, sqlc.CreateCommentParams{
+			BlogID:     blog.ID,
+			ParentID:   uuid.NullUUID{UUID: parent.ID, Valid: true},
+			AuthorID:   uuid.NullUUID{UUID: testReader.UserID, Valid: true},
+			Body:       "Agreed",
+			Depth:      2,
+			ParentPath: parent.Path,
+		}).Return(sqlc.Comment{Depth: 2}, nil).Once()
+
+		comment, err := testUseCase(mockStore, now).CreateComment(ctx, blog.ID.String(), intfaces.CreateCommentParams{Body: " Agreed ", ParentID: parent.ID.String()})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), comment.Depth)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("replies nest up to the max depth", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		deepest := parent
+		deepest.Depth = 2
+		mockStore.On("GetBlog", ctx, blog.ID).Return(blog, nil).Once()
+		mockStore.On("GetComment", ctx, parent.ID).Return(deepest, nil).Once()
+
+		_, err := testUseCase(mockStore, now).CreateComment(ctx, blog.ID.String(), intfaces.CreateCommentParams{Body: "Too deep", ParentID: parent.ID.String()})
+
+		assert.Equal(t, http.StatusBadRequest, entity.GetStatusCode(err))
+		mockStore.AssertNotCalled(t, "CreateComment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("deleted comments can not be replied to", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		deleted := parent
+		deleted.DeletedAt = sql.NullTime{Time: now, Valid: true}
+		mockStore.On("GetBlog", ctx, blog.ID).Return(blog, nil).Once()
+		mockStore.On("GetComment", ctx, parent.ID).Return(deleted, nil).Once()
+
+		_, err := testUseCase(mockStore, now).CreateComment(ctx, blog.ID.String(), intfaces.CreateCommentParams{Body: "Hello", ParentID: parent.ID.String()})
+
+		assert.ErrorIs(t, err, entity.ErrConflict)
+	})
+
+	t.Run("drafts are hidden from readers", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		draft := blog
+		draft.Status = sqlc.BlogStatusDraft
+		mockStore.On("GetBlog", ctx, blog.ID).Return(draft, nil).Once()
+
+		_, err := testUseCase(mockStore, now).CreateComment(ctx, blog.ID.String(), intfaces.CreateCommentParams{Body: "First"})
+
+		assert.Equal(t, http.StatusNotFound, entity.GetStatusCode(err))
+	})
+}
+
+func TestMockListingCommentThread(t *testing.T) {
+	blog := sqlc.Blog{ID: uuid.New(), Status: sqlc.BlogStatusPublished}
+	root := sqlc.Comment{ID: uuid.New(), BlogID: blog.ID, Path: "000000000007."}
+
+	mockStore := new(mocks.Store)
+	ctx := entity.ContextWithIdentity(context.Background(), testReader)
+	mockStore.On("GetComment", ctx, root.ID).Return(root, nil).Once()
+	mockStore.On("GetBlog", ctx, blog.ID).Return(blog, nil).Once()
+	mockStore.On("ListCommentThread", ctx, sqlc.ListCommentThreadParams{BlogID: blog.ID, PathPrefix: root.Path, Limit: 3, Offset: 0}).
+		Return([]sqlc.Comment{root, {Path: "000000000007.000000000009."}, {Path: "000000000007.000000000011."}}, nil).Once()
+	mockStore.On("CountCommentThread", ctx, sqlc.CountCommentThreadParams{BlogID: blog.ID, PathPrefix: root.Path}).Return(int64(5), nil).Once()
+
+	res, err := testUseCase(mockStore, time.Now()).ListCommentThread(ctx, root.ID.String(), intfaces.ListCommentsParams{Page: "1", Limit: "2"})
+
+	assert.NoError(t, err)
+	assert.Len(t, res.Comments, 2)
+	assert.True(t, res.HasNext)
+	assert.Equal(t, int64(3), *res.TotalPages)
+	mockStore.AssertExpectations(t)
+}
+
+func TestMockEditingComment(t *testing.T) {
+	now := time.Now()
+	comment := sqlc.Comment{ID: uuid.New(), AuthorID: uuid.NullUUID{UUID: testReader.UserID, Valid: true}, CreatedAt: now.Add(-10 * time.Minute)}
+
+	t.Run("within the window", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("GetComment", ctx, comment.ID).Return(comment, nil).Once()
+		mockStore.On("EditComment", ctx, sqlc.EditCommentParams{Body: "Fixed", ID: comment.ID, PostedAfter: now.Add(-15 * time.Minute)}).
+			Return(comment, nil).Once()
+
+		_, err := testUseCase(mockStore, now).EditComment(ctx, comment.ID.String(), "Fixed")
+
+		assert.NoError(t, err)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("after the window", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		mockStore.On("GetComment", ctx, comment.ID).Return(comment, nil).Once()
+
+		_, err := testUseCase(mockStore, now.Add(10*time.Minute)).EditComment(ctx, comment.ID.String(), "Fixed")
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+		mockStore.AssertNotCalled(t, "EditComment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("someone else's comment", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetComment", ctx, comment.ID).Return(comment, nil).Once()
+
+		_, err := testUseCase(mockStore, now).EditComment(ctx, comment.ID.String(), "Fixed")
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+	})
+}
+
+func TestMockDeletingComment(t *testing.T) {
+	comment := sqlc.Comment{ID: uuid.New(), AuthorID: uuid.NullUUID{UUID: testReader.UserID, Valid: true}}
+
+	for name, identity := range map[string]*entity.Identity{"author": testReader, "moderator": testAdmin} {
+		t.Run(name+" deletes", func(t *testing.T) {
+			mockStore := new(mocks.Store)
+			ctx := entity.ContextWithIdentity(context.Background(), identity)
+			mockStore.On("GetComment", ctx, comment.ID).Return(comment, nil).Once()
+			mockStore.On("DeleteComment", ctx, comment.ID).Return(int64(1), nil).Once()
+
+			err := testUseCase(mockStore, time.Now()).DeleteComment(ctx, comment.ID.String())
+
+			assert.NoError(t, err)
+			mockStore.AssertExpectations(t)
+		})
+	}
+
+	t.Run("someone else's comment", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testAuthor)
+		mockStore.On("GetComment", ctx, comment.ID).Return(comment, nil).Once()
+
+		err := testUseCase(mockStore, time.Now()).DeleteComment(ctx, comment.ID.String())
+
+		assert.ErrorIs(t, err, entity.ErrForbidden)
+		mockStore.AssertNotCalled(t, "DeleteComment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("already deleted", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testReader)
+		deleted := comment
+		deleted.DeletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		mockStore.On("GetComment", ctx, comment.ID).Return(deleted, nil).Once()
+
+		err := testUseCase(mockStore, time.Now()).DeleteComment(ctx, comment.ID.String())
+
+		assert.Equal(t, http.StatusNotFound, entity.GetStatusCode(err))
+	})
+}
@@ -0,0 +1,22 @@
+package idempotency_usecase
+
+import (
+	"github.com/harmannkibue/golang_gin_clean_architecture/config"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"time"
+)
+
+type IdempotencyUseCase struct {
+	config *config.Config
+	store  intfaces.Store
+	// now is swapped in tests to control key expiry -.
+	now func() time.Time
+}
+
+func NewIdempotencyUseCase(store intfaces.Store, config *config.Config) intfaces.IntIdempotencyUsecase {
+	return &IdempotencyUseCase{
+		store:  store,
+		config: config,
+		now:    time.Now,
+	}
+}
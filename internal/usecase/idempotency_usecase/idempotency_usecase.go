@@ -0,0 +1,163 @@
+package idempotency_usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+)
+
+const (
+	// _keyField is the header the key is sent in, reported on invalid keys -.
+	_keyField     = "Idempotency-Key"
+	_maxKeyLength = 255
+)
+
+var errKeyInProgress = entity.ErrConflict.WithMessage("a request with this idempotency key is still being processed, retry later")
+
+// Begin claims the key for the caller. A live key is replayed when it was used for the same request and rejected otherwise -.
+func (usecase *IdempotencyUseCase) Begin(ctx context.Context, key string, request intfaces.IdempotentRequest) (*intfaces.IdempotentResponse, error) {
+	identity, err := usecaseIdentity(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = validKey(key); err != nil {
+		return nil, err
+	}
+
+	hash := fingerprint(request)
+
+	now := usecase.now()
+
+	_, err = usecase.store.CreateIdempotencyKey(ctx, sqlc.CreateIdempotencyKeyParams{
+		UserID:      identity.UserID,
+		Key:         key,
+		RequestHash: hash,
+		ExpiresAt:   now.Add(usecase.config.Idempotency.KeyTTL),
+		LockedUntil: sql.NullTime{Time: now.Add(usecase.config.Idempotency.LockLease), Valid: true},
+	})
+
+	// The key was free, had expired or its request had crashed, the request runs -.
+	if err == nil {
+		return nil, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, entity.ErrInternalServerError.Wrap(fmt.Errorf("uc.usecase.Begin - CreateIdempotencyKey: %w", err))
+	}
+
+	saved, err := usecase.store.GetIdempotencyKey(ctx, sqlc.GetIdempotencyKeyParams{UserID: identity.UserID, Key: key})
+
+	if err != nil {
+		// The key was released in between by its failed request, a retry claims it again -.
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errKeyInProgress
+		}
+
+		return nil, entity.ErrInternalServerError.Wrap(fmt.Errorf("uc.usecase.Begin - GetIdempotencyKey: %w", err))
+	}
+
+	if saved.RequestHash != hash {
+		return nil, entity.ErrIdempotencyKeyReuse.WithMessage("the idempotency key was already used with a different request, use a new key")
+	}
+
+	if !saved.ResponseStatus.Valid {
+		return nil, errKeyInProgress
+	}
+
+	return &intfaces.IdempotentResponse{
+		Status:      int(saved.ResponseStatus.Int32),
+		ContentType: saved.ResponseContentType.String,
+		Body:        saved.ResponseBody,
+	}, nil
+}
+
+// Complete saves the response of the request that claimed the key -.
+func (usecase *IdempotencyUseCase) Complete(ctx context.Context, key string, response intfaces.IdempotentResponse) error {
+	identity, err := usecaseIdentity(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	err = usecase.store.CompleteIdempotencyKey(ctx, sqlc.CompleteIdempotencyKeyParams{
+		ResponseStatus:      sql.NullInt32{Int32: int32(response.Status), Valid: true},
+		ResponseContentType: sql.NullString{String: response.ContentType, Valid: response.ContentType != ""},
+		ResponseBody:        response.Body,
+		UserID:              identity.UserID,
+		Key:                 key,
+	})
+
+	if err != nil {
+		return entity.ErrInternalServerError.Wrap(fmt.Errorf("uc.usecase.Complete - CompleteIdempotencyKey: %w", err))
+	}
+
+	return nil
+}
+
+// Release deletes the key of a request that failed so that a retry runs it again -.
+func (usecase *IdempotencyUseCase) Release(ctx context.Context, key string) error {
+	identity, err := usecaseIdentity(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if err = usecase.store.DeleteIdempotencyKey(ctx, sqlc.DeleteIdempotencyKeyParams{UserID: identity.UserID, Key: key}); err != nil {
+		return entity.ErrInternalServerError.Wrap(fmt.Errorf("uc.usecase.Release - DeleteIdempotencyKey: %w", err))
+	}
+
+	return nil
+}
+
+// PurgeExpiredKeys deletes the keys past their TTL, expired keys are already ignored so this only reclaims the space -.
+func (usecase *IdempotencyUseCase) PurgeExpiredKeys(ctx context.Context) (int64, error) {
+	purged, err := usecase.store.DeleteExpiredIdempotencyKeys(ctx)
+
+	if err != nil {
+		return 0, entity.ErrInternalServerError.Wrap(fmt.Errorf("uc.usecase.PurgeExpiredKeys - DeleteExpiredIdempotencyKeys: %w", err))
+	}
+
+	return purged, nil
+}
+
+// usecaseIdentity keys are scoped to the caller so that users can not replay each other's responses -.
+func usecaseIdentity(ctx context.Context) (*entity.Identity, error) {
+	identity, ok := entity.IdentityFromContext(ctx)
+
+	if !ok {
+		return nil, entity.ErrUnauthorized.WithMessage("authentication is required to use an idempotency key")
+	}
+
+	return identity, nil
+}
+
+func validKey(key string) error {
+	if key != "" && len(key) <= _maxKeyLength {
+		return nil
+	}
+
+	return entity.InvalidFields(entity.FieldError{
+		Field:   _keyField,
+		In:      "header",
+		Code:    "invalid_idempotency_key",
+		Message: fmt.Sprintf("must be 1 to %d characters long", _maxKeyLength),
+	})
+}
+
+// fingerprint hashes the parts of the request a retry has to repeat exactly -.
+func fingerprint(request intfaces.IdempotentRequest) string {
+	hash := sha256.New()
+
+	hash.Write([]byte(request.Method + " " + request.Path + "\n"))
+	hash.Write(request.Body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,139 @@
+package idempotency_usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/harmannkibue/golang_gin_clean_architecture/config"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/mocks"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/usecase/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testUser = &entity.Identity{UserID: uuid.New(), Role: entity.RoleAuthor}
+
+func testUseCase(store intfaces.Store, now time.Time) *IdempotencyUseCase {
+	return &IdempotencyUseCase{
+		store:  store,
+		config: &config.Config{Idempotency: config.Idempotency{KeyTTL: time.Hour, LockLease: time.Minute}},
+		now:    func() time.Time { return now },
+	}
+}
+
+func TestMockBeginningIdempotentRequest(t *testing.T) {
+	now := time.Now()
+	request := intfaces.IdempotentRequest{Method: http.MethodPost, Path: "/api/v1/blogs/create-blog/", Body: []byte(`{"title":"Go"}`)}
+	key := sqlc.GetIdempotencyKeyParams{UserID: testUser.UserID, Key: "key-1"}
+
+	t.Run("new key runs the request", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testUser)
+		mockStore.On("CreateIdempotencyKey", ctx, sqlc.CreateIdempotencyKeyParams{
+			UserID:      testUser.UserID,
+			Key:         "key-1",
+			RequestHash: fingerprint(request),
+			ExpiresAt:   now.Add(time.Hour),
+			LockedUntil: sql.NullTime{Time: now.Add(time.Minute), Valid: true},
+		}).Return(sqlc.IdempotencyKey{}, nil).Once()
+
+		saved, err := testUseCase(mockStore, now).Begin(ctx, "key-1", request)
+
+		assert.NoError(t, err)
+		assert.Nil(t, saved)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("repeated key replays the response", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testUser)
+		mockStore.On("CreateIdempotencyKey", ctx, mock.AnythingOfType("sqlc.CreateIdempotencyKeyParams")).
+			Return(sqlc.IdempotencyKey{}, sql.ErrNoRows).Once()
+		mockStore.On("GetIdempotencyKey", ctx, key).Return(sqlc.IdempotencyKey{
+			RequestHash:         fingerprint(request),
+			ResponseStatus:      sql.NullInt32{Int32: http.StatusCreated, Valid: true},
+			ResponseContentType: sql.NullString{String: "application/json", Valid: true},
+			ResponseBody:        []byte(`{"id":"1"}`),
+		}, nil).Once()
+
+		saved, err := testUseCase(mockStore, now).Begin(ctx, "key-1", request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &intfaces.IdempotentResponse{Status: http.StatusCreated, ContentType: "application/json", Body: []byte(`{"id":"1"}`)}, saved)
+		mockStore.AssertExpectations(t)
+	})
+
+	t.Run("repeated key with another body", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testUser)
+		mockStore.On("CreateIdempotencyKey", ctx, mock.AnythingOfType("sqlc.CreateIdempotencyKeyParams")).
+			Return(sqlc.IdempotencyKey{}, sql.ErrNoRows).Once()
+		mockStore.On("GetIdempotencyKey", ctx, key).Return(sqlc.IdempotencyKey{
+			RequestHash:    fingerprint(intfaces.IdempotentRequest{Method: http.MethodPost, Path: request.Path, Body: []byte(`{"title":"Rust"}`)}),
+			ResponseStatus: sql.NullInt32{Int32: http.StatusCreated, Valid: true},
+		}, nil).Once()
+
+		_, err := testUseCase(mockStore, now).Begin(ctx, "key-1", request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, entity.GetStatusCode(err))
+		assert.True(t, errors.Is(err, entity.ErrIdempotencyKeyReuse))
+	})
+
+	t.Run("request still running", func(t *testing.T) {
+		mockStore := new(mocks.Store)
+		ctx := entity.ContextWithIdentity(context.Background(), testUser)
+		mockStore.On("CreateIdempotencyKey", ctx, mock.AnythingOfType("sqlc.CreateIdempotencyKeyParams")).
+			Return(sqlc.IdempotencyKey{}, sql.ErrNoRows).Once()
+		mockStore.On("GetIdempotencyKey", ctx, key).Return(sqlc.IdempotencyKey{RequestHash: fingerprint(request)}, nil).Once()
+
+		_, err := testUseCase(mockStore, now).Begin(ctx, "key-1", request)
+
+		assert.Equal(t, http.StatusConflict, entity.GetStatusCode(err))
+	})
+
+	for name, tc := range map[string]struct {
+		ctx    context.Context
+		key    string
+		status int
+	}{
+		"anonymous caller": {context.Background(), "key-1", http.StatusUnauthorized},
+		"key too long":     {entity.ContextWithIdentity(context.Background(), testUser), strings.Repeat("k", 256), http.StatusUnprocessableEntity},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockStore := new(mocks.Store)
+
+			_, err := testUseCase(mockStore, now).Begin(tc.ctx, tc.key, request)
+
+			assert.Equal(t, tc.status, entity.GetStatusCode(err))
+			mockStore.AssertNotCalled(t, "CreateIdempotencyKey", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestMockCompletingIdempotentRequest(t *testing.T) {
+	mockStore := new(mocks.Store)
+	ctx := entity.ContextWithIdentity(context.Background(), testUser)
+	mockStore.On("CompleteIdempotencyKey", ctx, sqlc.CompleteIdempotencyKeyParams{
+		ResponseStatus:      sql.NullInt32{Int32: http.StatusCreated, Valid: true},
+		ResponseContentType: sql.NullString{String: "application/json", Valid: true},
+		ResponseBody:        []byte(`{}`),
+		UserID:              testUser.UserID,
+		Key:                 "key-1",
+	}).Return(nil).Once()
+
+	err := testUseCase(mockStore, time.Now()).Complete(ctx, "key-1", intfaces.IdempotentResponse{
+		Status:      http.StatusCreated,
+		ContentType: "application/json",
+		Body:        []byte(`{}`),
+	})
+
+	assert.NoError(t, err)
+	mockStore.AssertExpectations(t)
+}
@@ -1,21 +1,222 @@
 -- name: CreateBlog :one
 INSERT INTO blog (
-    descriptions
+    title, slug, body, summary, descriptions, author_id
 ) VALUES (
-             $1
+             $1, $2, $3, $4, $5, $6
          )
     RETURNING *;
 
 -- name: GetBlog :one
 SELECT * FROM blog
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetBlogBySlug :one
+SELECT * FROM blog
+WHERE slug = $1 AND deleted_at IS NULL LIMIT 1;
 
 -- name: ListBlog :many
+-- Identifiers can not be bound as parameters hence each whitelisted sort key has its own CASE, the ones not picked are all null and do not affect the order.
 SELECT * FROM blog
-ORDER BY created_at
-    LIMIT $1
-OFFSET $2
+WHERE (sqlc.narg('author_id')::uuid IS NULL OR author_id = sqlc.narg('author_id'))
+  AND (status = 'published' OR author_id = sqlc.narg('viewer_id'))
+  AND deleted_at IS NULL
+  AND (sqlc.narg('status')::text IS NULL OR status::text = sqlc.narg('status'))
+  AND (sqlc.narg('user_role')::text IS NULL OR user_role::text = sqlc.narg('user_role'))
+  AND (sqlc.narg('created_after')::timestamptz IS NULL OR created_at >= sqlc.narg('created_after'))
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR created_at < sqlc.narg('created_before'))
+  AND (coalesce(cardinality(sqlc.arg('tags')::text[]), 0) = 0 OR (
+      SELECT count(*) FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id
+      WHERE blog_tags.blog_id = blog.id AND tags.slug = ANY(sqlc.arg('tags')::text[])
+  ) >= CASE WHEN sqlc.arg('match_all_tags')::bool THEN cardinality(sqlc.arg('tags')::text[]) ELSE 1 END)
+ORDER BY
+         CASE WHEN sqlc.arg('sort1')::text = 'created_at' THEN created_at END,
+         CASE WHEN sqlc.arg('sort1')::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN sqlc.arg('sort1')::text = 'updated_at' THEN updated_at END,
+         CASE WHEN sqlc.arg('sort1')::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN sqlc.arg('sort1')::text = 'published_at' THEN published_at END,
+         CASE WHEN sqlc.arg('sort1')::text = '-published_at' THEN published_at END DESC,
+         CASE WHEN sqlc.arg('sort1')::text = 'title' THEN title END,
+         CASE WHEN sqlc.arg('sort1')::text = '-title' THEN title END DESC,
+         CASE WHEN sqlc.arg('sort2')::text = 'created_at' THEN created_at END,
+         CASE WHEN sqlc.arg('sort2')::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN sqlc.arg('sort2')::text = 'updated_at' THEN updated_at END,
+         CASE WHEN sqlc.arg('sort2')::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN sqlc.arg('sort2')::text = 'published_at' THEN published_at END,
+         CASE WHEN sqlc.arg('sort2')::text = '-published_at' THEN published_at END DESC,
+         CASE WHEN sqlc.arg('sort2')::text = 'title' THEN title END,
+         CASE WHEN sqlc.arg('sort2')::text = '-title' THEN title END DESC,
+         CASE WHEN sqlc.arg('sort3')::text = 'created_at' THEN created_at END,
+         CASE WHEN sqlc.arg('sort3')::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN sqlc.arg('sort3')::text = 'updated_at' THEN updated_at END,
+         CASE WHEN sqlc.arg('sort3')::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN sqlc.arg('sort3')::text = 'published_at' THEN published_at END,
+         CASE WHEN sqlc.arg('sort3')::text = '-published_at' THEN published_at END DESC,
+         CASE WHEN sqlc.arg('sort3')::text = 'title' THEN title END,
+         CASE WHEN sqlc.arg('sort3')::text = '-title' THEN title END DESC,
+         id
+    LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset')
 ;
 
--- name: DeleteBlog :exec
-DELETE FROM blog WHERE id = $1;
+-- name: TrashBlog :execrows
+-- Moves the blog to the trash, nothing is trashed when the blog has been changed since it was read at version.
+UPDATE blog
+SET deleted_at = now(),
+    version    = version + 1
+WHERE id = $1
+  AND version = $2
+  AND deleted_at IS NULL;
+
+-- name: UpdateBlog :one
+UPDATE blog
+SET title        = $2,
+    body         = $3,
+    summary      = $4,
+    descriptions = $5,
+    updated_at   = now(),
+    version      = version + 1
+WHERE id = $1
+  AND version = $6
+    RETURNING *;
+
+-- name: PatchBlog :one
+UPDATE blog
+SET title        = COALESCE(sqlc.narg('title'), title),
+    body         = COALESCE(sqlc.narg('body'), body),
+    summary      = CASE WHEN sqlc.arg('set_summary')::bool THEN sqlc.narg('summary') ELSE summary END,
+    descriptions = COALESCE(sqlc.narg('descriptions'), descriptions),
+    updated_at   = now(),
+    version      = version + 1
+WHERE id = sqlc.arg('id')
+  AND version = sqlc.arg('version')
+    RETURNING *;
+
+-- name: ListBlogAfter :many
+SELECT * FROM blog
+WHERE (created_at, id) > (sqlc.arg('created_at')::timestamptz, sqlc.arg('id')::uuid)
+  AND (sqlc.narg('author_id')::uuid IS NULL OR author_id = sqlc.narg('author_id'))
+  AND (status = 'published' OR author_id = sqlc.narg('viewer_id'))
+  AND deleted_at IS NULL
+  AND (sqlc.narg('status')::text IS NULL OR status::text = sqlc.narg('status'))
+  AND (sqlc.narg('user_role')::text IS NULL OR user_role::text = sqlc.narg('user_role'))
+  AND (sqlc.narg('created_after')::timestamptz IS NULL OR created_at >= sqlc.narg('created_after'))
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR created_at < sqlc.narg('created_before'))
+  AND (coalesce(cardinality(sqlc.arg('tags')::text[]), 0) = 0 OR (
+      SELECT count(*) FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id
+      WHERE blog_tags.blog_id = blog.id AND tags.slug = ANY(sqlc.arg('tags')::text[])
+  ) >= CASE WHEN sqlc.arg('match_all_tags')::bool THEN cardinality(sqlc.arg('tags')::text[]) ELSE 1 END)
+ORDER BY created_at, id
+    LIMIT sqlc.arg('limit')
+;
+
+-- name: ListBlogBefore :many
+SELECT * FROM blog
+WHERE (created_at, id) < (sqlc.arg('created_at')::timestamptz, sqlc.arg('id')::uuid)
+  AND (sqlc.narg('author_id')::uuid IS NULL OR author_id = sqlc.narg('author_id'))
+  AND (status = 'published' OR author_id = sqlc.narg('viewer_id'))
+  AND deleted_at IS NULL
+  AND (sqlc.narg('status')::text IS NULL OR status::text = sqlc.narg('status'))
+  AND (sqlc.narg('user_role')::text IS NULL OR user_role::text = sqlc.narg('user_role'))
+  AND (sqlc.narg('created_after')::timestamptz IS NULL OR created_at >= sqlc.narg('created_after'))
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR created_at < sqlc.narg('created_before'))
+  AND (coalesce(cardinality(sqlc.arg('tags')::text[]), 0) = 0 OR (
+      SELECT count(*) FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id
+      WHERE blog_tags.blog_id = blog.id AND tags.slug = ANY(sqlc.arg('tags')::text[])
+  ) >= CASE WHEN sqlc.arg('match_all_tags')::bool THEN cardinality(sqlc.arg('tags')::text[]) ELSE 1 END)
+ORDER BY created_at DESC, id DESC
+    LIMIT sqlc.arg('limit')
+;
+
+-- name: UpdateBlogStatus :one
+-- Only moves the blog when it is still in from_status at version, a concurrent change makes it return no rows.
+UPDATE blog
+SET status       = sqlc.arg('status'),
+    published_at = sqlc.narg('published_at'),
+    updated_at   = now(),
+    version      = version + 1
+WHERE id = sqlc.arg('id')
+  AND status = sqlc.arg('from_status')
+  AND version = sqlc.arg('version')
+    RETURNING *;
+
+-- name: PublishDueBlogs :many
+-- Locked rows are skipped so that replicas running the scheduler at the same time publish different blogs.
+WITH due AS (
+    SELECT id FROM blog
+    WHERE status = 'scheduled'
+      AND published_at <= now()
+      AND deleted_at IS NULL
+    ORDER BY published_at
+        LIMIT sqlc.arg('limit')
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE blog
+SET status     = 'published',
+    updated_at = now(),
+    version    = version + 1
+FROM due
+WHERE blog.id = due.id
+    RETURNING blog.*;
+
+-- name: SearchBlogs :many
+SELECT blog.*,
+       ts_rank(search_vector, query)::real AS rank,
+       ts_headline('english', concat_ws(' ', summary, body), query,
+                   'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS headline
+FROM blog, to_tsquery('english', sqlc.arg('query')) query
+WHERE search_vector @@ query
+  AND (status = 'published' OR author_id = sqlc.narg('viewer_id'))
+  AND deleted_at IS NULL
+ORDER BY rank DESC, created_at DESC, id
+    LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset')
+;
+
+-- name: CountBlogs :one
+SELECT count(*) FROM blog
+WHERE (sqlc.narg('author_id')::uuid IS NULL OR author_id = sqlc.narg('author_id'))
+  AND (status = 'published' OR author_id = sqlc.narg('viewer_id'))
+  AND deleted_at IS NULL
+  AND (sqlc.narg('status')::text IS NULL OR status::text = sqlc.narg('status'))
+  AND (sqlc.narg('user_role')::text IS NULL OR user_role::text = sqlc.narg('user_role'))
+  AND (sqlc.narg('created_after')::timestamptz IS NULL OR created_at >= sqlc.narg('created_after'))
+  AND (sqlc.narg('created_before')::timestamptz IS NULL OR created_at < sqlc.narg('created_before'))
+  AND (coalesce(cardinality(sqlc.arg('tags')::text[]), 0) = 0 OR (
+      SELECT count(*) FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id
+      WHERE blog_tags.blog_id = blog.id AND tags.slug = ANY(sqlc.arg('tags')::text[])
+  ) >= CASE WHEN sqlc.arg('match_all_tags')::bool THEN cardinality(sqlc.arg('tags')::text[]) ELSE 1 END)
+;
+
+-- name: CountSearchBlogs :one
+SELECT count(*) FROM blog
+WHERE search_vector @@ to_tsquery('english', sqlc.arg('query'))
+  AND (status = 'published' OR author_id = sqlc.narg('viewer_id'))
+  AND deleted_at IS NULL
+;
+
+-- name: ListTrashedBlogs :many
+SELECT * FROM blog
+WHERE deleted_at IS NOT NULL
+  AND (sqlc.narg('author_id')::uuid IS NULL OR author_id = sqlc.narg('author_id'))
+ORDER BY deleted_at DESC, id
+    LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset')
+;
+
+-- name: CountTrashedBlogs :one
+SELECT count(*) FROM blog
+WHERE deleted_at IS NOT NULL
+  AND (sqlc.narg('author_id')::uuid IS NULL OR author_id = sqlc.narg('author_id'))
+;
+
+-- name: RestoreBlog :one
+UPDATE blog
+SET deleted_at = NULL,
+    updated_at = now(),
+    version    = version + 1
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+    RETURNING *;
+
+-- name: PurgeTrashedBlogs :execrows
+DELETE FROM blog WHERE deleted_at < sqlc.arg('deleted_before');
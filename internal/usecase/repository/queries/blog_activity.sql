@@ -0,0 +1,55 @@
+-- name: UpsertBlogReaction :one
+INSERT INTO blog_reactions (blog_id, user_id, kind)
+VALUES ($1, $2, $3)
+ON CONFLICT (blog_id, user_id) DO UPDATE SET kind = EXCLUDED.kind, created_at = now()
+    RETURNING *;
+
+-- name: DeleteBlogReaction :execrows
+DELETE FROM blog_reactions WHERE blog_id = $1 AND user_id = $2;
+
+-- name: GetBlogReaction :one
+SELECT * FROM blog_reactions
+WHERE blog_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: CountBlogReactions :many
+SELECT kind, count(*) FROM blog_reactions
+WHERE blog_id = $1
+GROUP BY kind
+ORDER BY kind;
+
+-- name: AddBlogViews :exec
+-- Adds a batch of views to one bucket and shard, the views of blogs deleted since they were counted are dropped.
+INSERT INTO blog_view_counters (blog_id, bucket, shard, views)
+SELECT counted.blog_id, sqlc.arg('bucket'), sqlc.arg('shard'), counted.views
+FROM unnest(sqlc.arg('blog_ids')::uuid[], sqlc.arg('views')::bigint[]) AS counted (blog_id, views)
+WHERE EXISTS (SELECT 1 FROM blog WHERE blog.id = counted.blog_id)
+ON CONFLICT (blog_id, bucket, shard) DO UPDATE SET views = blog_view_counters.views + EXCLUDED.views;
+
+-- name: SumBlogViews :one
+SELECT coalesce(sum(views), 0)::bigint FROM blog_view_counters
+WHERE blog_id = $1;
+
+-- name: ListPopularBlogs :many
+-- Every view and reaction since the start of the window counts for half as much with every half life that has passed,
+-- a reaction counts as reaction_weight views.
+WITH scores AS (
+    SELECT activity.blog_id, sum(activity.weight) AS score
+    FROM (
+             SELECT blog_id, views * exp(ln(0.5) * extract(epoch FROM now() - bucket) / sqlc.arg('half_life_seconds')::float8) AS weight
+             FROM blog_view_counters
+             WHERE bucket >= sqlc.arg('since')
+             UNION ALL
+             SELECT blog_id, sqlc.arg('reaction_weight')::float8 * exp(ln(0.5) * extract(epoch FROM now() - created_at) / sqlc.arg('half_life_seconds')::float8)
+             FROM blog_reactions
+             WHERE created_at >= sqlc.arg('since')
+         ) AS activity
+    GROUP BY activity.blog_id
+)
+SELECT blog.*, scores.score::float8 AS score
+FROM scores
+JOIN blog ON blog.id = scores.blog_id
+WHERE blog.status = 'published' AND blog.deleted_at IS NULL
+ORDER BY scores.score DESC, blog.id
+    LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset')
+;
@@ -0,0 +1,24 @@
+-- name: CreateBlogRevision :one
+INSERT INTO blog_revisions (
+    blog_id, revision, title, body, summary, descriptions, editor_id
+) VALUES (
+             $1, $2, $3, $4, $5, $6, $7
+         )
+    RETURNING *;
+
+-- name: GetBlogRevision :one
+SELECT * FROM blog_revisions
+WHERE blog_id = $1 AND revision = $2 LIMIT 1;
+
+-- name: ListBlogRevisions :many
+-- The bodies are left out of the listing, a single revision is fetched for its content.
+SELECT blog_id, revision, title, editor_id, created_at FROM blog_revisions
+WHERE blog_id = sqlc.arg('blog_id')
+ORDER BY revision DESC
+    LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset')
+;
+
+-- name: CountBlogRevisions :one
+SELECT count(*) FROM blog_revisions
+WHERE blog_id = $1;
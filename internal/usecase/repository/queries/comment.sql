@@ -0,0 +1,41 @@
+-- name: CreateComment :one
+INSERT INTO comments (blog_id, parent_id, author_id, body, depth, path)
+VALUES (sqlc.arg('blog_id'), sqlc.narg('parent_id'), sqlc.narg('author_id'), sqlc.arg('body'), sqlc.arg('depth'),
+        sqlc.arg('parent_path')::text || lpad(nextval('comment_path_seq')::text, 12, '0') || '.')
+    RETURNING *;
+
+-- name: GetComment :one
+SELECT * FROM comments
+WHERE id = $1 LIMIT 1;
+
+-- name: ListCommentThread :many
+-- Lists the comments under the path prefix depth first, an empty prefix lists all the comments of the blog.
+SELECT * FROM comments
+WHERE blog_id = sqlc.arg('blog_id') AND path LIKE sqlc.arg('path_prefix')::text || '%'
+ORDER BY path
+    LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset')
+;
+
+-- name: CountCommentThread :one
+SELECT count(*) FROM comments
+WHERE blog_id = sqlc.arg('blog_id') AND path LIKE sqlc.arg('path_prefix')::text || '%'
+;
+
+-- name: EditComment :one
+-- Returns no rows once the comment is deleted or the edit window has closed.
+UPDATE comments
+SET body      = sqlc.arg('body'),
+    edited_at = now()
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+  AND created_at > sqlc.arg('posted_after')
+    RETURNING *;
+
+-- name: DeleteComment :execrows
+-- The row is kept without its content so that the replies keep their place in the thread.
+UPDATE comments
+SET body       = '',
+    author_id  = NULL,
+    deleted_at = now()
+WHERE id = $1 AND deleted_at IS NULL;
@@ -0,0 +1,41 @@
+-- name: CreateIdempotencyKey :one
+-- Claims the key for a new request, an expired key is claimed again while a live one returns no rows.
+-- A request whose lease ran out without a response is claimed again by a retry of the same request.
+INSERT INTO idempotency_keys (
+    user_id, key, request_hash, expires_at, locked_until
+) VALUES (
+             sqlc.arg('user_id'), sqlc.arg('key'), sqlc.arg('request_hash'), sqlc.arg('expires_at'), sqlc.arg('locked_until')
+         )
+ON CONFLICT (user_id, key) DO UPDATE
+    SET request_hash          = EXCLUDED.request_hash,
+        response_status       = NULL,
+        response_content_type = NULL,
+        response_body         = NULL,
+        created_at            = now(),
+        expires_at            = EXCLUDED.expires_at,
+        locked_until          = EXCLUDED.locked_until
+    WHERE idempotency_keys.expires_at <= now()
+       OR (idempotency_keys.response_status IS NULL
+           AND idempotency_keys.request_hash = EXCLUDED.request_hash
+           AND (idempotency_keys.locked_until IS NULL OR idempotency_keys.locked_until <= now()))
+    RETURNING *;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE user_id = sqlc.arg('user_id') AND key = sqlc.arg('key') LIMIT 1;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET response_status       = sqlc.arg('response_status'),
+    response_content_type = sqlc.arg('response_content_type'),
+    response_body         = sqlc.arg('response_body'),
+    locked_until          = NULL
+WHERE user_id = sqlc.arg('user_id') AND key = sqlc.arg('key');
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE user_id = sqlc.arg('user_id') AND key = sqlc.arg('key');
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at <= now();
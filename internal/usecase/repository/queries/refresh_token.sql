@@ -0,0 +1,22 @@
+-- name: CreateRefreshToken :one
+INSERT INTO refresh_tokens (
+    user_id, token_hash, expires_at
+) VALUES (
+             $1, $2, $3
+         )
+    RETURNING *;
+
+-- name: GetRefreshTokenByHash :one
+SELECT * FROM refresh_tokens
+WHERE token_hash = $1 LIMIT 1;
+
+-- name: RevokeRefreshToken :execrows
+UPDATE refresh_tokens
+SET revoked_at  = now(),
+    replaced_by = sqlc.narg('replaced_by')
+WHERE id = sqlc.arg('id') AND revoked_at IS NULL;
+
+-- name: RevokeUserRefreshTokens :exec
+UPDATE refresh_tokens
+SET revoked_at = now()
+WHERE user_id = $1 AND revoked_at IS NULL;
@@ -0,0 +1,36 @@
+-- name: UpsertTags :many
+-- Creates the tags that do not exist yet, the existing ones keep their name. The slugs must be unique.
+INSERT INTO tags (name, slug)
+SELECT unnest(sqlc.arg('names')::text[]), unnest(sqlc.arg('slugs')::text[])
+ON CONFLICT (slug) DO UPDATE SET slug = EXCLUDED.slug
+    RETURNING *;
+
+-- name: DeleteBlogTags :exec
+DELETE FROM blog_tags WHERE blog_id = $1;
+
+-- name: AddBlogTags :exec
+INSERT INTO blog_tags (blog_id, tag_id)
+SELECT sqlc.arg('blog_id'), id FROM tags
+WHERE slug = ANY(sqlc.arg('slugs')::text[])
+ON CONFLICT DO NOTHING;
+
+-- name: ListBlogTags :many
+SELECT tags.* FROM tags
+JOIN blog_tags ON blog_tags.tag_id = tags.id
+WHERE blog_tags.blog_id = $1
+ORDER BY tags.slug;
+
+-- name: ListTags :many
+-- The usage only counts the published blogs, the ones everybody can see.
+SELECT tags.*, count(blog.id) AS usage_count
+FROM tags
+LEFT JOIN blog_tags ON blog_tags.tag_id = tags.id
+LEFT JOIN blog ON blog.id = blog_tags.blog_id AND blog.status = 'published' AND blog.deleted_at IS NULL
+GROUP BY tags.id
+ORDER BY usage_count DESC, tags.slug
+    LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset')
+;
+
+-- name: CountTags :one
+SELECT count(*) FROM tags;
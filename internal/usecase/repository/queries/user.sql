@@ -0,0 +1,22 @@
+-- name: CreateUser :one
+INSERT INTO users (
+    email, password_hash, user_role
+) VALUES (
+             $1, $2, $3
+         )
+    RETURNING *;
+
+-- name: GetUser :one
+SELECT * FROM users
+WHERE id = $1 LIMIT 1;
+
+-- name: GetUserByEmail :one
+SELECT * FROM users
+WHERE email = $1 LIMIT 1;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET user_role  = sqlc.arg('user_role'),
+    updated_at = now()
+WHERE id = sqlc.arg('id')
+    RETURNING *;
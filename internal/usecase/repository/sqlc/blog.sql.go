@@ -8,21 +8,97 @@ package sqlc
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const countBlogs = `-- name: CountBlogs :one
+SELECT count(*) FROM blog
+WHERE ($1::uuid IS NULL OR author_id = $1)
+  AND (status = 'published' OR author_id = $2)
+  AND deleted_at IS NULL
+  AND ($3::text IS NULL OR status::text = $3)
+  AND ($4::text IS NULL OR user_role::text = $4)
+  AND ($5::timestamptz IS NULL OR created_at >= $5)
+  AND ($6::timestamptz IS NULL OR created_at < $6)
+  AND (coalesce(cardinality($7::text[]), 0) = 0 OR (
+      SELECT count(*) FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id
+      WHERE blog_tags.blog_id = blog.id AND tags.slug = ANY($7::text[])
+  ) >= CASE WHEN $8::bool THEN cardinality($7::text[]) ELSE 1 END)
+`
+
+type CountBlogsParams struct {
+	AuthorID      uuid.NullUUID  `json:"authorId"`
+	ViewerID      uuid.NullUUID  `json:"viewerId"`
+	Status        sql.NullString `json:"status"`
+	UserRole      sql.NullString `json:"userRole"`
+	CreatedAfter  sql.NullTime   `json:"createdAfter"`
+	CreatedBefore sql.NullTime   `json:"createdBefore"`
+	Tags          []string       `json:"tags"`
+	MatchAllTags  bool           `json:"matchAllTags"`
+}
+
+func (q *Queries) CountBlogs(ctx context.Context, arg CountBlogsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countBlogs, arg.AuthorID, arg.ViewerID, arg.Status, arg.UserRole, arg.CreatedAfter, arg.CreatedBefore, pq.Array(arg.Tags), arg.MatchAllTags)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchBlogs = `-- name: CountSearchBlogs :one
+SELECT count(*) FROM blog
+WHERE search_vector @@ to_tsquery('english', $1)
+  AND (status = 'published' OR author_id = $2)
+  AND deleted_at IS NULL
+`
+
+type CountSearchBlogsParams struct {
+	Query    string        `json:"query"`
+	ViewerID uuid.NullUUID `json:"viewerId"`
+}
+
+func (q *Queries) CountSearchBlogs(ctx context.Context, arg CountSearchBlogsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countSearchBlogs, arg.Query, arg.ViewerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTrashedBlogs = `-- name: CountTrashedBlogs :one
+SELECT count(*) FROM blog
+WHERE deleted_at IS NOT NULL
+  AND ($1::uuid IS NULL OR author_id = $1)
+`
+
+func (q *Queries) CountTrashedBlogs(ctx context.Context, authorID uuid.NullUUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTrashedBlogs, authorID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createBlog = `-- name: CreateBlog :one
 INSERT INTO blog (
-    descriptions
+    title, slug, body, summary, descriptions, author_id
 ) VALUES (
-             $1
+             $1, $2, $3, $4, $5, $6
          )
-    RETURNING id, descriptions, user_role, created_at, updated_at
+    RETURNING id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at
 `
 
-func (q *Queries) CreateBlog(ctx context.Context, descriptions sql.NullString) (Blog, error) {
-	row := q.db.QueryRowContext(ctx, createBlog, descriptions)
+type CreateBlogParams struct {
+	Title        string         `json:"title"`
+	Slug         string         `json:"slug"`
+	Body         string         `json:"body"`
+	Summary      sql.NullString `json:"summary"`
+	Descriptions sql.NullString `json:"descriptions"`
+	AuthorID     uuid.NullUUID  `json:"authorId"`
+}
+
+func (q *Queries) CreateBlog(ctx context.Context, arg CreateBlogParams) (Blog, error) {
+	row := q.db.QueryRowContext(ctx, createBlog, arg.Title, arg.Slug, arg.Body, arg.Summary, arg.Descriptions, arg.AuthorID)
 	var i Blog
 	err := row.Scan(
 		&i.ID,
@@ -30,26 +106,55 @@ func (q *Queries) CreateBlog(ctx context.Context, descriptions sql.NullString) (
 		&i.UserRole,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AuthorID,
+		&i.Title,
+		&i.Slug,
+		&i.Body,
+		&i.Summary,
+		&i.Status,
+		&i.PublishedAt,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteBlog = `-- name: DeleteBlog :exec
-DELETE FROM blog WHERE id = $1
+const getBlog = `-- name: GetBlog :one
+SELECT id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at FROM blog
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
-func (q *Queries) DeleteBlog(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteBlog, id)
-	return err
+func (q *Queries) GetBlog(ctx context.Context, id uuid.UUID) (Blog, error) {
+	row := q.db.QueryRowContext(ctx, getBlog, id)
+	var i Blog
+	err := row.Scan(
+		&i.ID,
+		&i.Descriptions,
+		&i.UserRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AuthorID,
+		&i.Title,
+		&i.Slug,
+		&i.Body,
+		&i.Summary,
+		&i.Status,
+		&i.PublishedAt,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
 }
 
-const getBlog = `-- name: GetBlog :one
-SELECT id, descriptions, user_role, created_at, updated_at FROM blog
-WHERE id = $1 LIMIT 1
+const getBlogBySlug = `-- name: GetBlogBySlug :one
+SELECT id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at FROM blog
+WHERE slug = $1 AND deleted_at IS NULL LIMIT 1
 `
 
-func (q *Queries) GetBlog(ctx context.Context, id uuid.UUID) (Blog, error) {
-	row := q.db.QueryRowContext(ctx, getBlog, id)
+func (q *Queries) GetBlogBySlug(ctx context.Context, slug string) (Blog, error) {
+	row := q.db.QueryRowContext(ctx, getBlogBySlug, slug)
 	var i Blog
 	err := row.Scan(
 		&i.ID,
@@ -57,24 +162,224 @@ func (q *Queries) GetBlog(ctx context.Context, id uuid.UUID) (Blog, error) {
 		&i.UserRole,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AuthorID,
+		&i.Title,
+		&i.Slug,
+		&i.Body,
+		&i.Summary,
+		&i.Status,
+		&i.PublishedAt,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
 const listBlog = `-- name: ListBlog :many
-SELECT id, descriptions, user_role, created_at, updated_at FROM blog
-ORDER BY created_at
-    LIMIT $1
-OFFSET $2
+SELECT id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at FROM blog
+WHERE ($1::uuid IS NULL OR author_id = $1)
+  AND (status = 'published' OR author_id = $2)
+  AND deleted_at IS NULL
+  AND ($3::text IS NULL OR status::text = $3)
+  AND ($4::text IS NULL OR user_role::text = $4)
+  AND ($5::timestamptz IS NULL OR created_at >= $5)
+  AND ($6::timestamptz IS NULL OR created_at < $6)
+  AND (coalesce(cardinality($7::text[]), 0) = 0 OR (
+      SELECT count(*) FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id
+      WHERE blog_tags.blog_id = blog.id AND tags.slug = ANY($7::text[])
+  ) >= CASE WHEN $8::bool THEN cardinality($7::text[]) ELSE 1 END)
+ORDER BY
+         CASE WHEN $9::text = 'created_at' THEN created_at END,
+         CASE WHEN $9::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN $9::text = 'updated_at' THEN updated_at END,
+         CASE WHEN $9::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN $9::text = 'published_at' THEN published_at END,
+         CASE WHEN $9::text = '-published_at' THEN published_at END DESC,
+         CASE WHEN $9::text = 'title' THEN title END,
+         CASE WHEN $9::text = '-title' THEN title END DESC,
+         CASE WHEN $10::text = 'created_at' THEN created_at END,
+         CASE WHEN $10::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN $10::text = 'updated_at' THEN updated_at END,
+         CASE WHEN $10::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN $10::text = 'published_at' THEN published_at END,
+         CASE WHEN $10::text = '-published_at' THEN published_at END DESC,
+         CASE WHEN $10::text = 'title' THEN title END,
+         CASE WHEN $10::text = '-title' THEN title END DESC,
+         CASE WHEN $11::text = 'created_at' THEN created_at END,
+         CASE WHEN $11::text = '-created_at' THEN created_at END DESC,
+         CASE WHEN $11::text = 'updated_at' THEN updated_at END,
+         CASE WHEN $11::text = '-updated_at' THEN updated_at END DESC,
+         CASE WHEN $11::text = 'published_at' THEN published_at END,
+         CASE WHEN $11::text = '-published_at' THEN published_at END DESC,
+         CASE WHEN $11::text = 'title' THEN title END,
+         CASE WHEN $11::text = '-title' THEN title END DESC,
+         id
+    LIMIT $12
+OFFSET $13
 `
 
 type ListBlogParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	AuthorID      uuid.NullUUID  `json:"authorId"`
+	ViewerID      uuid.NullUUID  `json:"viewerId"`
+	Status        sql.NullString `json:"status"`
+	UserRole      sql.NullString `json:"userRole"`
+	CreatedAfter  sql.NullTime   `json:"createdAfter"`
+	CreatedBefore sql.NullTime   `json:"createdBefore"`
+	Tags          []string       `json:"tags"`
+	MatchAllTags  bool           `json:"matchAllTags"`
+	Sort1         string         `json:"sort1"`
+	Sort2         string         `json:"sort2"`
+	Sort3         string         `json:"sort3"`
+	Limit         int32          `json:"limit"`
+	Offset        int32          `json:"offset"`
 }
 
+// Identifiers can not be bound as parameters hence each whitelisted sort key has its own CASE, the ones not picked are all null and do not affect the order.
 func (q *Queries) ListBlog(ctx context.Context, arg ListBlogParams) ([]Blog, error) {
-	rows, err := q.db.QueryContext(ctx, listBlog, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listBlog, arg.AuthorID, arg.ViewerID, arg.Status, arg.UserRole, arg.CreatedAfter, arg.CreatedBefore, pq.Array(arg.Tags), arg.MatchAllTags, arg.Sort1, arg.Sort2, arg.Sort3, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Blog{}
+	for rows.Next() {
+		var i Blog
+		if err := rows.Scan(
+			&i.ID,
+			&i.Descriptions,
+			&i.UserRole,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Summary,
+			&i.Status,
+			&i.PublishedAt,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBlogAfter = `-- name: ListBlogAfter :many
+SELECT id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at FROM blog
+WHERE (created_at, id) > ($1::timestamptz, $2::uuid)
+  AND ($3::uuid IS NULL OR author_id = $3)
+  AND (status = 'published' OR author_id = $4)
+  AND deleted_at IS NULL
+  AND ($5::text IS NULL OR status::text = $5)
+  AND ($6::text IS NULL OR user_role::text = $6)
+  AND ($7::timestamptz IS NULL OR created_at >= $7)
+  AND ($8::timestamptz IS NULL OR created_at < $8)
+  AND (coalesce(cardinality($9::text[]), 0) = 0 OR (
+      SELECT count(*) FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id
+      WHERE blog_tags.blog_id = blog.id AND tags.slug = ANY($9::text[])
+  ) >= CASE WHEN $10::bool THEN cardinality($9::text[]) ELSE 1 END)
+ORDER BY created_at, id
+    LIMIT $11
+`
+
+type ListBlogAfterParams struct {
+	CreatedAt     time.Time      `json:"createdAt"`
+	ID            uuid.UUID      `json:"id"`
+	AuthorID      uuid.NullUUID  `json:"authorId"`
+	ViewerID      uuid.NullUUID  `json:"viewerId"`
+	Status        sql.NullString `json:"status"`
+	UserRole      sql.NullString `json:"userRole"`
+	CreatedAfter  sql.NullTime   `json:"createdAfter"`
+	CreatedBefore sql.NullTime   `json:"createdBefore"`
+	Tags          []string       `json:"tags"`
+	MatchAllTags  bool           `json:"matchAllTags"`
+	Limit         int32          `json:"limit"`
+}
+
+func (q *Queries) ListBlogAfter(ctx context.Context, arg ListBlogAfterParams) ([]Blog, error) {
+	rows, err := q.db.QueryContext(ctx, listBlogAfter, arg.CreatedAt, arg.ID, arg.AuthorID, arg.ViewerID, arg.Status, arg.UserRole, arg.CreatedAfter, arg.CreatedBefore, pq.Array(arg.Tags), arg.MatchAllTags, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Blog{}
+	for rows.Next() {
+		var i Blog
+		if err := rows.Scan(
+			&i.ID,
+			&i.Descriptions,
+			&i.UserRole,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Summary,
+			&i.Status,
+			&i.PublishedAt,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBlogBefore = `-- name: ListBlogBefore :many
+SELECT id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at FROM blog
+WHERE (created_at, id) < ($1::timestamptz, $2::uuid)
+  AND ($3::uuid IS NULL OR author_id = $3)
+  AND (status = 'published' OR author_id = $4)
+  AND deleted_at IS NULL
+  AND ($5::text IS NULL OR status::text = $5)
+  AND ($6::text IS NULL OR user_role::text = $6)
+  AND ($7::timestamptz IS NULL OR created_at >= $7)
+  AND ($8::timestamptz IS NULL OR created_at < $8)
+  AND (coalesce(cardinality($9::text[]), 0) = 0 OR (
+      SELECT count(*) FROM blog_tags JOIN tags ON tags.id = blog_tags.tag_id
+      WHERE blog_tags.blog_id = blog.id AND tags.slug = ANY($9::text[])
+  ) >= CASE WHEN $10::bool THEN cardinality($9::text[]) ELSE 1 END)
+ORDER BY created_at DESC, id DESC
+    LIMIT $11
+`
+
+type ListBlogBeforeParams struct {
+	CreatedAt     time.Time      `json:"createdAt"`
+	ID            uuid.UUID      `json:"id"`
+	AuthorID      uuid.NullUUID  `json:"authorId"`
+	ViewerID      uuid.NullUUID  `json:"viewerId"`
+	Status        sql.NullString `json:"status"`
+	UserRole      sql.NullString `json:"userRole"`
+	CreatedAfter  sql.NullTime   `json:"createdAfter"`
+	CreatedBefore sql.NullTime   `json:"createdBefore"`
+	Tags          []string       `json:"tags"`
+	MatchAllTags  bool           `json:"matchAllTags"`
+	Limit         int32          `json:"limit"`
+}
+
+func (q *Queries) ListBlogBefore(ctx context.Context, arg ListBlogBeforeParams) ([]Blog, error) {
+	rows, err := q.db.QueryContext(ctx, listBlogBefore, arg.CreatedAt, arg.ID, arg.AuthorID, arg.ViewerID, arg.Status, arg.UserRole, arg.CreatedAfter, arg.CreatedBefore, pq.Array(arg.Tags), arg.MatchAllTags, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +393,16 @@ func (q *Queries) ListBlog(ctx context.Context, arg ListBlogParams) ([]Blog, err
 			&i.UserRole,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.AuthorID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Summary,
+			&i.Status,
+			&i.PublishedAt,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -101,3 +416,401 @@ func (q *Queries) ListBlog(ctx context.Context, arg ListBlogParams) ([]Blog, err
 	}
 	return items, nil
 }
+
+const listTrashedBlogs = `-- name: ListTrashedBlogs :many
+SELECT id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at FROM blog
+WHERE deleted_at IS NOT NULL
+  AND ($1::uuid IS NULL OR author_id = $1)
+ORDER BY deleted_at DESC, id
+    LIMIT $2
+OFFSET $3
+`
+
+type ListTrashedBlogsParams struct {
+	AuthorID uuid.NullUUID `json:"authorId"`
+	Limit    int32         `json:"limit"`
+	Offset   int32         `json:"offset"`
+}
+
+func (q *Queries) ListTrashedBlogs(ctx context.Context, arg ListTrashedBlogsParams) ([]Blog, error) {
+	rows, err := q.db.QueryContext(ctx, listTrashedBlogs, arg.AuthorID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Blog{}
+	for rows.Next() {
+		var i Blog
+		if err := rows.Scan(
+			&i.ID,
+			&i.Descriptions,
+			&i.UserRole,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Summary,
+			&i.Status,
+			&i.PublishedAt,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const patchBlog = `-- name: PatchBlog :one
+UPDATE blog
+SET title        = COALESCE($1, title),
+    body         = COALESCE($2, body),
+    summary      = CASE WHEN $3::bool THEN $4 ELSE summary END,
+    descriptions = COALESCE($5, descriptions),
+    updated_at   = now(),
+    version      = version + 1
+WHERE id = $6
+  AND version = $7
+    RETURNING id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at
+`
+
+type PatchBlogParams struct {
+	Title        sql.NullString `json:"title"`
+	Body         sql.NullString `json:"body"`
+	SetSummary   bool           `json:"setSummary"`
+	Summary      sql.NullString `json:"summary"`
+	Descriptions sql.NullString `json:"descriptions"`
+	ID           uuid.UUID      `json:"id"`
+	Version      int32          `json:"version"`
+}
+
+func (q *Queries) PatchBlog(ctx context.Context, arg PatchBlogParams) (Blog, error) {
+	row := q.db.QueryRowContext(ctx, patchBlog, arg.Title, arg.Body, arg.SetSummary, arg.Summary, arg.Descriptions, arg.ID, arg.Version)
+	var i Blog
+	err := row.Scan(
+		&i.ID,
+		&i.Descriptions,
+		&i.UserRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AuthorID,
+		&i.Title,
+		&i.Slug,
+		&i.Body,
+		&i.Summary,
+		&i.Status,
+		&i.PublishedAt,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const publishDueBlogs = `-- name: PublishDueBlogs :many
+WITH due AS (
+    SELECT id FROM blog
+    WHERE status = 'scheduled'
+      AND published_at <= now()
+      AND deleted_at IS NULL
+    ORDER BY published_at
+        LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE blog
+SET status     = 'published',
+    updated_at = now(),
+    version    = version + 1
+FROM due
+WHERE blog.id = due.id
+    RETURNING blog.id, blog.descriptions, blog.user_role, blog.created_at, blog.updated_at, blog.author_id, blog.title, blog.slug, blog.body, blog.summary, blog.status, blog.published_at, blog.search_vector, blog.version, blog.deleted_at
+`
+
+// Locked rows are skipped so that replicas running the scheduler at the same time publish different blogs.
+func (q *Queries) PublishDueBlogs(ctx context.Context, limit int32) ([]Blog, error) {
+	rows, err := q.db.QueryContext(ctx, publishDueBlogs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Blog{}
+	for rows.Next() {
+		var i Blog
+		if err := rows.Scan(
+			&i.ID,
+			&i.Descriptions,
+			&i.UserRole,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Summary,
+			&i.Status,
+			&i.PublishedAt,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeTrashedBlogs = `-- name: PurgeTrashedBlogs :execrows
+DELETE FROM blog WHERE deleted_at < $1
+`
+
+func (q *Queries) PurgeTrashedBlogs(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeTrashedBlogs, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreBlog = `-- name: RestoreBlog :one
+UPDATE blog
+SET deleted_at = NULL,
+    updated_at = now(),
+    version    = version + 1
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+    RETURNING id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at
+`
+
+func (q *Queries) RestoreBlog(ctx context.Context, id uuid.UUID) (Blog, error) {
+	row := q.db.QueryRowContext(ctx, restoreBlog, id)
+	var i Blog
+	err := row.Scan(
+		&i.ID,
+		&i.Descriptions,
+		&i.UserRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AuthorID,
+		&i.Title,
+		&i.Slug,
+		&i.Body,
+		&i.Summary,
+		&i.Status,
+		&i.PublishedAt,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const searchBlogs = `-- name: SearchBlogs :many
+SELECT blog.id, blog.descriptions, blog.user_role, blog.created_at, blog.updated_at, blog.author_id, blog.title, blog.slug, blog.body, blog.summary, blog.status, blog.published_at, blog.search_vector, blog.version, blog.deleted_at,
+       ts_rank(search_vector, query)::real AS rank,
+       ts_headline('english', concat_ws(' ', summary, body), query,
+                   'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10') AS headline
+FROM blog, to_tsquery('english', $1) query
+WHERE search_vector @@ query
+  AND (status = 'published' OR author_id = $2)
+  AND deleted_at IS NULL
+ORDER BY rank DESC, created_at DESC, id
+    LIMIT $3
+OFFSET $4
+`
+
+type SearchBlogsRow struct {
+	ID           uuid.UUID      `json:"id"`
+	Descriptions sql.NullString `json:"descriptions"`
+	UserRole     UserRoles      `json:"userRole"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    sql.NullTime   `json:"updatedAt"`
+	AuthorID     uuid.NullUUID  `json:"authorId"`
+	Title        string         `json:"title"`
+	Slug         string         `json:"slug"`
+	Body         string         `json:"body"`
+	Summary      sql.NullString `json:"summary"`
+	Status       BlogStatus     `json:"status"`
+	PublishedAt  sql.NullTime   `json:"publishedAt"`
+	SearchVector string         `json:"-"`
+	Version      int32          `json:"version"`
+	DeletedAt    sql.NullTime   `json:"deletedAt"`
+	Rank         float32        `json:"rank"`
+	Headline     string         `json:"headline"`
+}
+
+type SearchBlogsParams struct {
+	Query    string        `json:"query"`
+	ViewerID uuid.NullUUID `json:"viewerId"`
+	Limit    int32         `json:"limit"`
+	Offset   int32         `json:"offset"`
+}
+
+func (q *Queries) SearchBlogs(ctx context.Context, arg SearchBlogsParams) ([]SearchBlogsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchBlogs, arg.Query, arg.ViewerID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchBlogsRow{}
+	for rows.Next() {
+		var i SearchBlogsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Descriptions,
+			&i.UserRole,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AuthorID,
+			&i.Title,
+			&i.Slug,
+			&i.Body,
+			&i.Summary,
+			&i.Status,
+			&i.PublishedAt,
+			&i.SearchVector,
+			&i.Version,
+			&i.DeletedAt,
+			&i.Rank,
+			&i.Headline,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trashBlog = `-- name: TrashBlog :execrows
+UPDATE blog
+SET deleted_at = now(),
+    version    = version + 1
+WHERE id = $1
+  AND version = $2
+  AND deleted_at IS NULL
+`
+
+type TrashBlogParams struct {
+	ID      uuid.UUID `json:"id"`
+	Version int32     `json:"version"`
+}
+
+// Moves the blog to the trash, nothing is trashed when the blog has been changed since it was read at version.
+func (q *Queries) TrashBlog(ctx context.Context, arg TrashBlogParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, trashBlog, arg.ID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateBlog = `-- name: UpdateBlog :one
+UPDATE blog
+SET title        = $2,
+    body         = $3,
+    summary      = $4,
+    descriptions = $5,
+    updated_at   = now(),
+    version      = version + 1
+WHERE id = $1
+  AND version = $6
+    RETURNING id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at
+`
+
+type UpdateBlogParams struct {
+	ID           uuid.UUID      `json:"id"`
+	Title        string         `json:"title"`
+	Body         string         `json:"body"`
+	Summary      sql.NullString `json:"summary"`
+	Descriptions sql.NullString `json:"descriptions"`
+	Version      int32          `json:"version"`
+}
+
+func (q *Queries) UpdateBlog(ctx context.Context, arg UpdateBlogParams) (Blog, error) {
+	row := q.db.QueryRowContext(ctx, updateBlog, arg.ID, arg.Title, arg.Body, arg.Summary, arg.Descriptions, arg.Version)
+	var i Blog
+	err := row.Scan(
+		&i.ID,
+		&i.Descriptions,
+		&i.UserRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AuthorID,
+		&i.Title,
+		&i.Slug,
+		&i.Body,
+		&i.Summary,
+		&i.Status,
+		&i.PublishedAt,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateBlogStatus = `-- name: UpdateBlogStatus :one
+UPDATE blog
+SET status       = $1,
+    published_at = $2,
+    updated_at   = now(),
+    version      = version + 1
+WHERE id = $3
+  AND status = $4
+  AND version = $5
+    RETURNING id, descriptions, user_role, created_at, updated_at, author_id, title, slug, body, summary, status, published_at, search_vector, version, deleted_at
+`
+
+type UpdateBlogStatusParams struct {
+	Status      BlogStatus   `json:"status"`
+	PublishedAt sql.NullTime `json:"publishedAt"`
+	ID          uuid.UUID    `json:"id"`
+	FromStatus  BlogStatus   `json:"fromStatus"`
+	Version     int32        `json:"version"`
+}
+
+// Only moves the blog when it is still in from_status at version, a concurrent change makes it return no rows.
+func (q *Queries) UpdateBlogStatus(ctx context.Context, arg UpdateBlogStatusParams) (Blog, error) {
+	row := q.db.QueryRowContext(ctx, updateBlogStatus, arg.Status, arg.PublishedAt, arg.ID, arg.FromStatus, arg.Version)
+	var i Blog
+	err := row.Scan(
+		&i.ID,
+		&i.Descriptions,
+		&i.UserRole,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AuthorID,
+		&i.Title,
+		&i.Slug,
+		&i.Body,
+		&i.Summary,
+		&i.Status,
+		&i.PublishedAt,
+		&i.SearchVector,
+		&i.Version,
+		&i.DeletedAt,
+	)
+	return i, err
+}
@@ -12,11 +12,72 @@ import (
 	"github.com/google/uuid"
 )
 
+type BlogStatus string
+
+const (
+	BlogStatusDraft     BlogStatus = "draft"
+	BlogStatusScheduled BlogStatus = "scheduled"
+	BlogStatusPublished BlogStatus = "published"
+	BlogStatusArchived  BlogStatus = "archived"
+)
+
+func (e *BlogStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BlogStatus(s)
+	case string:
+		*e = BlogStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BlogStatus: %T", src)
+	}
+	return nil
+}
+
+func AllBlogStatusValues() []BlogStatus {
+	return []BlogStatus{
+		BlogStatusDraft,
+		BlogStatusScheduled,
+		BlogStatusPublished,
+		BlogStatusArchived,
+	}
+}
+
+type ReactionKind string
+
+const (
+	ReactionKindLike       ReactionKind = "like"
+	ReactionKindLove       ReactionKind = "love"
+	ReactionKindInsightful ReactionKind = "insightful"
+	ReactionKindFunny      ReactionKind = "funny"
+)
+
+func (e *ReactionKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReactionKind(s)
+	case string:
+		*e = ReactionKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReactionKind: %T", src)
+	}
+	return nil
+}
+
+func AllReactionKindValues() []ReactionKind {
+	return []ReactionKind{
+		ReactionKindLike,
+		ReactionKindLove,
+		ReactionKindInsightful,
+		ReactionKindFunny,
+	}
+}
+
 type UserRoles string
 
 const (
 	UserRolesAuthor UserRoles = "author"
 	UserRolesReader UserRoles = "reader"
+	UserRolesAdmin  UserRoles = "admin"
 )
 
 func (e *UserRoles) Scan(src interface{}) error {
@@ -35,6 +96,7 @@ func AllUserRolesValues() []UserRoles {
 	return []UserRoles{
 		UserRolesAuthor,
 		UserRolesReader,
+		UserRolesAdmin,
 	}
 }
 
@@ -44,4 +106,90 @@ type Blog struct {
 	UserRole     UserRoles      `json:"userRole"`
 	CreatedAt    time.Time      `json:"createdAt"`
 	UpdatedAt    sql.NullTime   `json:"updatedAt"`
+	AuthorID     uuid.NullUUID  `json:"authorId"`
+	Title        string         `json:"title"`
+	Slug         string         `json:"slug"`
+	Body         string         `json:"body"`
+	Summary      sql.NullString `json:"summary"`
+	Status       BlogStatus     `json:"status"`
+	PublishedAt  sql.NullTime   `json:"publishedAt"`
+	SearchVector string         `json:"-"`
+	Version      int32          `json:"version"`
+	DeletedAt    sql.NullTime   `json:"deletedAt"`
+}
+
+type BlogReaction struct {
+	BlogID    uuid.UUID    `json:"blogId"`
+	UserID    uuid.UUID    `json:"userId"`
+	Kind      ReactionKind `json:"kind"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+type BlogRevision struct {
+	BlogID       uuid.UUID      `json:"blogId"`
+	Revision     int32          `json:"revision"`
+	Title        string         `json:"title"`
+	Body         string         `json:"body"`
+	Summary      sql.NullString `json:"summary"`
+	Descriptions sql.NullString `json:"descriptions"`
+	EditorID     uuid.NullUUID  `json:"editorId"`
+	CreatedAt    time.Time      `json:"createdAt"`
+}
+
+type BlogViewCounter struct {
+	BlogID uuid.UUID `json:"blogId"`
+	Bucket time.Time `json:"bucket"`
+	Shard  int16     `json:"shard"`
+	Views  int64     `json:"views"`
+}
+
+type Comment struct {
+	ID        uuid.UUID     `json:"id"`
+	BlogID    uuid.UUID     `json:"blogId"`
+	ParentID  uuid.NullUUID `json:"parentId"`
+	AuthorID  uuid.NullUUID `json:"authorId"`
+	Body      string        `json:"body"`
+	Depth     int32         `json:"depth"`
+	Path      string        `json:"path"`
+	CreatedAt time.Time     `json:"createdAt"`
+	EditedAt  sql.NullTime  `json:"editedAt"`
+	DeletedAt sql.NullTime  `json:"deletedAt"`
+}
+
+type IdempotencyKey struct {
+	UserID              uuid.UUID      `json:"userId"`
+	Key                 string         `json:"key"`
+	RequestHash         string         `json:"requestHash"`
+	ResponseStatus      sql.NullInt32  `json:"responseStatus"`
+	ResponseContentType sql.NullString `json:"responseContentType"`
+	ResponseBody        []byte         `json:"responseBody"`
+	CreatedAt           time.Time      `json:"createdAt"`
+	ExpiresAt           time.Time      `json:"expiresAt"`
+	LockedUntil         sql.NullTime   `json:"lockedUntil"`
+}
+
+type RefreshToken struct {
+	ID         uuid.UUID     `json:"id"`
+	UserID     uuid.UUID     `json:"userId"`
+	TokenHash  string        `json:"tokenHash"`
+	ExpiresAt  time.Time     `json:"expiresAt"`
+	RevokedAt  sql.NullTime  `json:"revokedAt"`
+	ReplacedBy uuid.NullUUID `json:"replacedBy"`
+	CreatedAt  time.Time     `json:"createdAt"`
+}
+
+type Tag struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Slug      string    `json:"slug"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type User struct {
+	ID           uuid.UUID    `json:"id"`
+	Email        string       `json:"email"`
+	PasswordHash string       `json:"passwordHash"`
+	UserRole     UserRoles    `json:"userRole"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	UpdatedAt    sql.NullTime `json:"updatedAt"`
 }
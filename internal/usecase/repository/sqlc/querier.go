@@ -6,16 +6,81 @@ package sqlc
 
 import (
 	"context"
-	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
-	CreateBlog(ctx context.Context, descriptions sql.NullString) (Blog, error)
-	DeleteBlog(ctx context.Context, id uuid.UUID) error
+	AddBlogTags(ctx context.Context, arg AddBlogTagsParams) error
+	// Adds a batch of views to one bucket and shard, the views of blogs deleted since they were counted are dropped.
+	AddBlogViews(ctx context.Context, arg AddBlogViewsParams) error
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error
+	CountBlogReactions(ctx context.Context, blogID uuid.UUID) ([]CountBlogReactionsRow, error)
+	CountBlogRevisions(ctx context.Context, blogID uuid.UUID) (int64, error)
+	CountBlogs(ctx context.Context, arg CountBlogsParams) (int64, error)
+	CountCommentThread(ctx context.Context, arg CountCommentThreadParams) (int64, error)
+	CountSearchBlogs(ctx context.Context, arg CountSearchBlogsParams) (int64, error)
+	CountTags(ctx context.Context) (int64, error)
+	CountTrashedBlogs(ctx context.Context, authorID uuid.NullUUID) (int64, error)
+	CreateBlog(ctx context.Context, arg CreateBlogParams) (Blog, error)
+	CreateBlogRevision(ctx context.Context, arg CreateBlogRevisionParams) (BlogRevision, error)
+	CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error)
+	// Claims the key for a new request, an expired key is claimed again while a live one returns no rows.
+	// A request whose lease ran out without a response is claimed again by a retry of the same request.
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (RefreshToken, error)
+	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeleteBlogReaction(ctx context.Context, arg DeleteBlogReactionParams) (int64, error)
+	DeleteBlogTags(ctx context.Context, blogID uuid.UUID) error
+	// The row is kept without its content so that the replies keep their place in the thread.
+	DeleteComment(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error
+	// Returns no rows once the comment is deleted or the edit window has closed.
+	EditComment(ctx context.Context, arg EditCommentParams) (Comment, error)
 	GetBlog(ctx context.Context, id uuid.UUID) (Blog, error)
+	GetBlogBySlug(ctx context.Context, slug string) (Blog, error)
+	GetBlogReaction(ctx context.Context, arg GetBlogReactionParams) (BlogReaction, error)
+	GetBlogRevision(ctx context.Context, arg GetBlogRevisionParams) (BlogRevision, error)
+	GetComment(ctx context.Context, id uuid.UUID) (Comment, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (RefreshToken, error)
+	GetUser(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	// Identifiers can not be bound as parameters hence each whitelisted sort key has its own CASE, the ones not picked are all null and do not affect the order.
 	ListBlog(ctx context.Context, arg ListBlogParams) ([]Blog, error)
+	ListBlogAfter(ctx context.Context, arg ListBlogAfterParams) ([]Blog, error)
+	ListBlogBefore(ctx context.Context, arg ListBlogBeforeParams) ([]Blog, error)
+	// The bodies are left out of the listing, a single revision is fetched for its content.
+	ListBlogRevisions(ctx context.Context, arg ListBlogRevisionsParams) ([]ListBlogRevisionsRow, error)
+	ListBlogTags(ctx context.Context, blogID uuid.UUID) ([]Tag, error)
+	// Lists the comments under the path prefix depth first, an empty prefix lists all the comments of the blog.
+	ListCommentThread(ctx context.Context, arg ListCommentThreadParams) ([]Comment, error)
+	// Every view and reaction since the start of the window counts for half as much with every half life that has passed,
+	// a reaction counts as reaction_weight views.
+	ListPopularBlogs(ctx context.Context, arg ListPopularBlogsParams) ([]ListPopularBlogsRow, error)
+	// The usage only counts the published blogs, the ones everybody can see.
+	ListTags(ctx context.Context, arg ListTagsParams) ([]ListTagsRow, error)
+	ListTrashedBlogs(ctx context.Context, arg ListTrashedBlogsParams) ([]Blog, error)
+	PatchBlog(ctx context.Context, arg PatchBlogParams) (Blog, error)
+	// Locked rows are skipped so that replicas running the scheduler at the same time publish different blogs.
+	PublishDueBlogs(ctx context.Context, limit int32) ([]Blog, error)
+	PurgeTrashedBlogs(ctx context.Context, deletedBefore time.Time) (int64, error)
+	RestoreBlog(ctx context.Context, id uuid.UUID) (Blog, error)
+	RevokeRefreshToken(ctx context.Context, arg RevokeRefreshTokenParams) (int64, error)
+	RevokeUserRefreshTokens(ctx context.Context, userID uuid.UUID) error
+	SearchBlogs(ctx context.Context, arg SearchBlogsParams) ([]SearchBlogsRow, error)
+	SumBlogViews(ctx context.Context, blogID uuid.UUID) (int64, error)
+	// Moves the blog to the trash, nothing is trashed when the blog has been changed since it was read at version.
+	TrashBlog(ctx context.Context, arg TrashBlogParams) (int64, error)
+	UpdateBlog(ctx context.Context, arg UpdateBlogParams) (Blog, error)
+	// Only moves the blog when it is still in from_status at version, a concurrent change makes it return no rows.
+	UpdateBlogStatus(ctx context.Context, arg UpdateBlogStatusParams) (Blog, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
+	UpsertBlogReaction(ctx context.Context, arg UpsertBlogReactionParams) (BlogReaction, error)
+	// Creates the tags that do not exist yet, the existing ones keep their name. The slugs must be unique.
+	UpsertTags(ctx context.Context, arg UpsertTagsParams) ([]Tag, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CursorNext fetches the rows after the cursor position -.
+	CursorNext = "next"
+	// CursorPrevious fetches the rows before the cursor position -.
+	CursorPrevious = "prev"
+)
+
+// ErrInvalidCursor is returned when a cursor is malformed or its signature does not match -.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the keyset position of a row in a listing ordered by (created_at, id) -.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+	Direction string
+}
+
+// cursorPayload is the wire format of the cursor. Time is kept in micro seconds as that is the postgres precision -.
+type cursorPayload struct {
+	CreatedAt int64     `json:"t"`
+	ID        uuid.UUID `json:"i"`
+	Direction string    `json:"d"`
+}
+
+// EncodeCursor serialises the cursor into an opaque url safe token signed with the secret -.
+func EncodeCursor(secret string, cursor Cursor) string {
+	payload, _ := json.Marshal(cursorPayload{
+		CreatedAt: cursor.CreatedAt.UnixMicro(),
+		ID:        cursor.ID,
+		Direction: cursor.Direction,
+	})
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signCursor(secret, encoded))
+}
+
+// DecodeCursor verifies the token signature and returns the cursor it holds -.
+func DecodeCursor(secret string, token string) (Cursor, error) {
+	encoded, signature, found := strings.Cut(token, ".")
+
+	if !found {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+
+	if err != nil || !hmac.Equal(sig, signCursor(secret, encoded)) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var p cursorPayload
+
+	if err = json.Unmarshal(payload, &p); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	if p.Direction != CursorNext && p.Direction != CursorPrevious {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{CreatedAt: time.UnixMicro(p.CreatedAt).UTC(), ID: p.ID, Direction: p.Direction}, nil
+}
+
+func signCursor(secret string, encoded string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(encoded))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PageLink is one target of an RFC 8288 Link header e.g rel next -.
+type PageLink struct {
+	Rel string
+	URL string
+}
+
+// RequestURL rebuilds the absolute url of the request, a proxy in front of the server passes the original scheme and host
+// in the X-Forwarded-Proto and X-Forwarded-Host headers. The headers are only honoured when the request came through a
+// trusted proxy, anyone else could point the links at a host of their choosing -.
+func RequestURL(r *http.Request, trustForwarded bool) *url.URL {
+	u := *r.URL
+
+	u.Scheme = "http"
+	if r.TLS != nil {
+		u.Scheme = "https"
+	}
+	u.Host = r.Host
+
+	if !trustForwarded {
+		return &u
+	}
+
+	if proto := firstHeaderValue(r.Header.Get("X-Forwarded-Proto")); proto == "http" || proto == "https" {
+		u.Scheme = proto
+	}
+	if host := firstHeaderValue(r.Header.Get("X-Forwarded-Host")); host != "" {
+		u.Host = host
+	}
+
+	return &u
+}
+
+// WithQuery returns the url with the query parameters set to the values, an empty value removes the parameter.
+// The other query parameters of the url are kept -.
+func WithQuery(u *url.URL, values map[string]string) string {
+	query := u.Query()
+
+	for key, value := range values {
+		if value == "" {
+			query.Del(key)
+		} else {
+			query.Set(key, value)
+		}
+	}
+
+	link := *u
+	link.RawQuery = query.Encode()
+
+	return link.String()
+}
+
+// LinkHeader formats the links as an RFC 8288 Link header value, links without a url are left out -.
+func LinkHeader(links ...PageLink) string {
+	values := make([]string, 0, len(links))
+
+	for _, link := range links {
+		if link.URL != "" {
+			values = append(values, "<"+link.URL+`>; rel="`+link.Rel+`"`)
+		}
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// firstHeaderValue a header set by a chain of proxies is a comma separated list, the first one is the client facing value -.
+func firstHeaderValue(value string) string {
+	first, _, _ := strings.Cut(value, ",")
+
+	return strings.TrimSpace(first)
+}
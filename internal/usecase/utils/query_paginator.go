@@ -2,9 +2,70 @@ package utils
 
 import (
 	"context"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity"
+	"github.com/harmannkibue/golang_gin_clean_architecture/internal/entity/intfaces"
+	"math"
 	"strconv"
 )
 
+// Paginator is the page of a listing asked for by the Page and ItemsPerPage query parameters -.
+type Paginator struct {
+	Page  int32
+	Limit int32
+}
+
+// ParsePaginator parses the page number and size, ErrBadRequest is returned for values below 1 -.
+func ParsePaginator(page string, limit string) (Paginator, error) {
+	p, err := StringToInt32(page)
+
+	if err != nil || p < 1 {
+		return Paginator{}, entity.ErrBadRequest.WithMessage("enter a page number of 1 or more for the Page query parameter").Wrap(err)
+	}
+
+	l, err := StringToInt32(limit)
+
+	if err != nil || l < 1 {
+		return Paginator{}, entity.ErrBadRequest.WithMessage("enter a page size of 1 or more for the ItemsPerPage query parameter").Wrap(err)
+	}
+
+	// The offset of the page is computed in int32 as the queries take it -.
+	if int64(p)*int64(l) > math.MaxInt32 {
+		return Paginator{}, entity.ErrBadRequest.WithMessage("enter a page number of at most " + strconv.Itoa(math.MaxInt32/int(l)) + " for the Page query parameter")
+	}
+
+	return Paginator{Page: p, Limit: l}, nil
+}
+
+// Params the limit and offset of the page query -.
+func (p Paginator) Params() (int32, int32) {
+	return PaginatorParams(p.Page, p.Limit)
+}
+
+// PaginatorPage trims the extra row fetched by PaginatorParams off the rows, it only tells whether there is a next
+// page. The rows are returned with the page links and metadata -.
+func PaginatorPage[T any](ctx context.Context, p Paginator, rows []T) ([]T, string, string, intfaces.PageMeta) {
+	nextPage, previousPage := PaginatorPages(ctx, p.Page, p.Limit, len(rows))
+	hasNext := len(rows) > int(p.Limit)
+
+	if hasNext {
+		rows = rows[:p.Limit]
+	}
+
+	return rows, nextPage, previousPage, intfaces.PageMeta{CurrentPage: p.Page, ItemsPerPage: p.Limit, HasNext: hasNext}
+}
+
+// SetPageTotals adds the counted total to the metadata, there are no page numbers to total in cursor mode -.
+func SetPageTotals(meta *intfaces.PageMeta, total int64) {
+	meta.TotalItems = &total
+
+	if meta.CurrentPage == 0 {
+		return
+	}
+
+	pages := PaginatorTotalPages(total, meta.ItemsPerPage)
+	meta.TotalPages = &pages
+}
+
 // PaginatorParams This function calculates the pagination for list data
 func PaginatorParams(page int32, limit int32) (int32, int32) {
 	Limit := limit + 1
@@ -27,7 +88,8 @@ func PaginatorPages(ctx context.Context, page int32, limit int32, dataLength int
 	} else {
 		previousPage = ""
 	}
-	if limit >= int32(dataLength) {
+	// PaginatorParams fetches one row more than the limit, it is only returned when there is a next page -.
+	if int32(dataLength) <= limit {
 		nextPage = ""
 	} else {
 		nextPage = "Page=" + strconv.Itoa(int(page)+1) + "&ItemsPerPage=" + strconv.Itoa(int(limit))
@@ -36,3 +98,12 @@ func PaginatorPages(ctx context.Context, page int32, limit int32, dataLength int
 	return nextPage, previousPage
 
 }
+
+// PaginatorTotalPages the number of pages of limit items needed for the total items, an empty listing still has its first page -.
+func PaginatorTotalPages(totalItems int64, limit int32) int64 {
+	if totalItems <= 0 || limit <= 0 {
+		return 1
+	}
+
+	return (totalItems + int64(limit) - 1) / int64(limit)
+}
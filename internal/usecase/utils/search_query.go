@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ErrEmptySearchQuery is returned when the search has no word to look for -.
+var ErrEmptySearchQuery = errors.New("empty search query")
+
+// ToTsQuery converts a search box query into the postgres to_tsquery syntax.
+// Words are and-ed, "quoted words" must follow each other, word* matches the words starting with word and -word excludes it.
+// Any other punctuation is dropped so that the user input can never be a malformed tsquery -.
+func ToTsQuery(search string) (string, error) {
+	var terms []string
+
+	for i, part := range strings.Split(search, `"`) {
+		// Odd parts are the text between a pair of quotes -.
+		if i%2 == 1 {
+			if words := searchWords(part); len(words) > 0 {
+				terms = append(terms, "("+strings.Join(words, " <-> ")+")")
+			}
+
+			continue
+		}
+
+		for _, field := range strings.Fields(part) {
+			negate := strings.HasPrefix(field, "-")
+			prefix := strings.HasSuffix(field, "*")
+
+			words := searchWords(field)
+
+			if len(words) == 0 {
+				continue
+			}
+
+			if prefix {
+				words[len(words)-1] += ":*"
+			}
+
+			term := strings.Join(words, " <-> ")
+
+			if len(words) > 1 {
+				term = "(" + term + ")"
+			}
+
+			if negate {
+				term = "!" + term
+			}
+
+			terms = append(terms, term)
+		}
+	}
+
+	if len(terms) == 0 {
+		return "", ErrEmptySearchQuery
+	}
+
+	return strings.Join(terms, " & "), nil
+}
+
+// searchWords splits the text on anything that is not a letter or a digit -.
+func searchWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// _maxSlugLength keeps the urls short, the collision suffix is added on top of it -.
+const _maxSlugLength = 80
+
+// Slugify turns a title into a lowercase url safe slug made of ascii letters, digits and hyphens.
+// Accents are stripped, the rest of the non ascii characters are dropped -.
+func Slugify(title string) string {
+	var b strings.Builder
+
+	hyphen := false
+
+	for _, r := range norm.NFKD.String(title) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			hyphen = false
+		case unicode.Is(unicode.Mn, r):
+			// Combining marks left over after the accents are decomposed -.
+		default:
+			hyphen = true
+		}
+
+		if b.Len() >= _maxSlugLength {
+			break
+		}
+	}
+
+	return strings.TrimRight(b.String()[:min(b.Len(), _maxSlugLength)], "-")
+}
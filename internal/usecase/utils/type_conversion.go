@@ -7,13 +7,15 @@ import (
 	"strings"
 )
 
+// StringToInt32 parses a base 10 number, values that are not numbers or overflow an int32 are errors -.
 func StringToInt32(str string) (int32, error) {
-	// Convert first into an integer
-	intValue, _ := strconv.Atoi(str)
+	intValue, err := strconv.ParseInt(strings.TrimSpace(str), 10, 32)
 
-	// Convert now the int to int 32
-	int32Value := int32(intValue)
-	return int32Value, nil
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(intValue), nil
 }
 
 func Float64ToInt64(num float64) (int64, error) {
@@ -37,13 +39,9 @@ func Int64ToString(num int64) string {
 // StringToInt64 converting string to int64
 func StringToInt64(stringNumber string) int64 {
 	num := strings.Split(stringNumber, ".")
-	int32val, err := StringToInt32(string(num[0]))
-	if err != nil {
-		print("No error and we have a value ", int32val)
-		return int64(int32val)
-	}
+	int64val, _ := strconv.ParseInt(strings.TrimSpace(num[0]), 10, 64)
 
-	return int64(int32val)
+	return int64val
 }
 
 // InterfaceToBytes -.
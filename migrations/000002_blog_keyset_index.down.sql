@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "blog_created_at_id_idx";
@@ -0,0 +1,2 @@
+-- Supports the (created_at, id) keyset pagination on the blogs listing.
+CREATE INDEX IF NOT EXISTS "blog_created_at_id_idx" ON "blog" ("created_at", "id");
@@ -0,0 +1,3 @@
+--  Make sure that all the foreign keys are taken care of by the order of the drops.
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,21 @@
+CREATE TABLE "users" (
+                         "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid ()),
+                         "email" varchar NOT NULL UNIQUE,
+                         "password_hash" varchar NOT NULL,
+                         "user_role" user_roles NOT NULL DEFAULT 'reader',
+                         "created_at" timestamptz NOT NULL DEFAULT (now()),
+                         "updated_at" timestamptz DEFAULT (now())
+);
+
+-- Refresh tokens are stored hashed, a rotated token points to the token that replaced it.
+CREATE TABLE "refresh_tokens" (
+                                  "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid ()),
+                                  "user_id" uuid NOT NULL REFERENCES "users" ("id") ON DELETE CASCADE,
+                                  "token_hash" varchar NOT NULL UNIQUE,
+                                  "expires_at" timestamptz NOT NULL,
+                                  "revoked_at" timestamptz,
+                                  "replaced_by" uuid REFERENCES "refresh_tokens" ("id"),
+                                  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "refresh_tokens" ("user_id");
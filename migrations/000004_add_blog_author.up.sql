@@ -0,0 +1,2 @@
+-- Blogs created before authentication existed have no author.
+ALTER TABLE "blog" ADD COLUMN "author_id" uuid REFERENCES "users" ("id") ON DELETE SET NULL;
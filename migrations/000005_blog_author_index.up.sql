@@ -0,0 +1,2 @@
+-- Supports listing the blogs of an author in (created_at, id) order.
+CREATE INDEX IF NOT EXISTS "blog_author_id_created_at_id_idx" ON "blog" ("author_id", "created_at", "id");
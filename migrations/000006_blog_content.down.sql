@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS "blog_slug_key";
+
+ALTER TABLE "blog"
+    DROP COLUMN IF EXISTS "published_at",
+    DROP COLUMN IF EXISTS "status",
+    DROP COLUMN IF EXISTS "summary",
+    DROP COLUMN IF EXISTS "body",
+    DROP COLUMN IF EXISTS "slug",
+    DROP COLUMN IF EXISTS "title";
+
+DROP TYPE IF EXISTS "blog_status";
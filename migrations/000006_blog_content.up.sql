@@ -0,0 +1,21 @@
+CREATE TYPE "blog_status" AS ENUM (
+  'draft',
+  'scheduled',
+  'published',
+  'archived'
+);
+
+ALTER TABLE "blog"
+    ADD COLUMN "title" varchar NOT NULL DEFAULT '',
+    ADD COLUMN "slug" varchar,
+    ADD COLUMN "body" text NOT NULL DEFAULT '',
+    ADD COLUMN "summary" text,
+    ADD COLUMN "status" blog_status NOT NULL DEFAULT 'draft',
+    ADD COLUMN "published_at" timestamptz;
+
+-- Blogs created before the publish lifecycle existed were already visible hence are published.
+UPDATE "blog" SET "slug" = "id"::text, "status" = 'published', "published_at" = "created_at";
+
+ALTER TABLE "blog" ALTER COLUMN "slug" SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "blog_slug_key" ON "blog" ("slug");
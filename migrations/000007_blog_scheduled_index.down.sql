@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "blog_scheduled_published_at_idx";
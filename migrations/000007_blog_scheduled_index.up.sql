@@ -0,0 +1,2 @@
+-- Supports the scheduler polling for the scheduled blogs that are due.
+CREATE INDEX IF NOT EXISTS "blog_scheduled_published_at_idx" ON "blog" ("published_at") WHERE "status" = 'scheduled';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "blog_search_vector_idx";
+
+ALTER TABLE "blog" DROP COLUMN IF EXISTS "search_vector";
@@ -0,0 +1,9 @@
+-- Weighted so that matches in the title rank above matches in the summary and body.
+ALTER TABLE "blog" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce("title", '')), 'A') ||
+    setweight(to_tsvector('english', coalesce("summary", '')), 'B') ||
+    setweight(to_tsvector('english', coalesce("body", '')), 'C') ||
+    setweight(to_tsvector('english', coalesce("descriptions", '')), 'D')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS "blog_search_vector_idx" ON "blog" USING GIN ("search_vector");
@@ -0,0 +1,15 @@
+-- The responses of requests sent with an Idempotency-Key, replayed when the client retries with the same key.
+-- Keys are scoped to the user sending them, a row without a response_status is a request still being processed.
+CREATE TABLE "idempotency_keys" (
+                                    "user_id" uuid NOT NULL REFERENCES "users" ("id") ON DELETE CASCADE,
+                                    "key" varchar NOT NULL,
+                                    "request_hash" varchar NOT NULL,
+                                    "response_status" integer,
+                                    "response_content_type" varchar,
+                                    "response_body" bytea,
+                                    "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                    "expires_at" timestamptz NOT NULL,
+                                    PRIMARY KEY ("user_id", "key")
+);
+
+CREATE INDEX ON "idempotency_keys" ("expires_at");
@@ -0,0 +1 @@
+ALTER TABLE "blog" DROP COLUMN IF EXISTS "version";
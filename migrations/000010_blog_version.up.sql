@@ -0,0 +1,2 @@
+-- Bumped by every change of a blog, the ETag is built from it so that clients can detect concurrent edits.
+ALTER TABLE "blog" ADD COLUMN "version" integer NOT NULL DEFAULT 1;
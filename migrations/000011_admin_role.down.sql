@@ -0,0 +1,22 @@
+-- Values can not be dropped from an enum, the type is recreated without admin and the admins are demoted to readers.
+UPDATE "users" SET "user_role" = 'reader' WHERE "user_role" = 'admin';
+UPDATE "blog" SET "user_role" = 'author' WHERE "user_role" = 'admin';
+
+ALTER TYPE "user_roles" RENAME TO "user_roles_old";
+
+CREATE TYPE "user_roles" AS ENUM (
+  'author',
+  'reader'
+);
+
+ALTER TABLE "blog"
+    ALTER COLUMN "user_role" DROP DEFAULT,
+    ALTER COLUMN "user_role" TYPE "user_roles" USING "user_role"::text::"user_roles",
+    ALTER COLUMN "user_role" SET DEFAULT 'author';
+
+ALTER TABLE "users"
+    ALTER COLUMN "user_role" DROP DEFAULT,
+    ALTER COLUMN "user_role" TYPE "user_roles" USING "user_role"::text::"user_roles",
+    ALTER COLUMN "user_role" SET DEFAULT 'reader';
+
+DROP TYPE "user_roles_old";
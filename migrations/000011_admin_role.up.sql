@@ -0,0 +1,2 @@
+-- Admins look after the blogs of every author e.g restoring the trashed ones, they are not granted on sign up.
+ALTER TYPE "user_roles" ADD VALUE IF NOT EXISTS 'admin';
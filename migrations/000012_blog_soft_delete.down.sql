@@ -0,0 +1,4 @@
+-- The trashed blogs were deleted as far as the clients know, they are not brought back by the rollback.
+DELETE FROM "blog" WHERE "deleted_at" IS NOT NULL;
+
+ALTER TABLE "blog" DROP COLUMN IF EXISTS "deleted_at";
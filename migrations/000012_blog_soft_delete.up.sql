@@ -0,0 +1,4 @@
+-- Deleted blogs are kept in the trash until the purge job removes them, a null deleted_at is a live blog.
+ALTER TABLE "blog" ADD COLUMN "deleted_at" timestamptz;
+
+CREATE INDEX ON "blog" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
@@ -0,0 +1,17 @@
+-- Every version of the content of a blog, a revision is numbered after the version of the blog that introduced it.
+CREATE TABLE "blog_revisions" (
+                                  "blog_id" uuid NOT NULL REFERENCES "blog" ("id") ON DELETE CASCADE,
+                                  "revision" integer NOT NULL,
+                                  "title" varchar NOT NULL,
+                                  "body" text NOT NULL,
+                                  "summary" text,
+                                  "descriptions" text,
+                                  "editor_id" uuid REFERENCES "users" ("id") ON DELETE SET NULL,
+                                  "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                  PRIMARY KEY ("blog_id", "revision")
+);
+
+-- The history of the existing blogs starts at their current content.
+INSERT INTO "blog_revisions" ("blog_id", "revision", "title", "body", "summary", "descriptions", "editor_id", "created_at")
+SELECT "id", "version", "title", "body", "summary", "descriptions", "author_id", COALESCE("updated_at", "created_at")
+FROM "blog";
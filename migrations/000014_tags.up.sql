@@ -0,0 +1,16 @@
+-- Tags are matched by their slug, the name is how the first author to use the tag wrote it.
+CREATE TABLE "tags" (
+                        "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid ()),
+                        "name" varchar NOT NULL,
+                        "slug" varchar NOT NULL UNIQUE,
+                        "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "blog_tags" (
+                             "blog_id" uuid NOT NULL REFERENCES "blog" ("id") ON DELETE CASCADE,
+                             "tag_id" uuid NOT NULL REFERENCES "tags" ("id") ON DELETE CASCADE,
+                             PRIMARY KEY ("blog_id", "tag_id")
+);
+
+-- The primary key covers the tags of a blog, this index covers the blogs of a tag.
+CREATE INDEX ON "blog_tags" ("tag_id");
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "comments";
+DROP SEQUENCE IF EXISTS "comment_path_seq";
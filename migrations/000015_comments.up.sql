@@ -0,0 +1,20 @@
+-- Hands out the path segments of the comments, later comments get larger segments.
+CREATE SEQUENCE "comment_path_seq";
+
+-- The path is the chain of the segments from the top level comment down to the comment, each ending with a dot.
+-- Sorting by it lists a thread depth first with the replies in the order they were posted.
+CREATE TABLE "comments" (
+                            "id" uuid PRIMARY KEY DEFAULT (gen_random_uuid ()),
+                            "blog_id" uuid NOT NULL REFERENCES "blog" ("id") ON DELETE CASCADE,
+                            "parent_id" uuid REFERENCES "comments" ("id") ON DELETE CASCADE,
+                            "author_id" uuid REFERENCES "users" ("id") ON DELETE SET NULL,
+                            "body" text NOT NULL,
+                            "depth" integer NOT NULL DEFAULT 0,
+                            "path" text COLLATE "C" NOT NULL,
+                            "created_at" timestamptz NOT NULL DEFAULT (now()),
+                            "edited_at" timestamptz,
+                            "deleted_at" timestamptz
+);
+
+-- Serves both the depth first order and the prefix match of a thread.
+CREATE UNIQUE INDEX ON "comments" ("blog_id", "path");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "blog_view_counters";
+DROP TABLE IF EXISTS "blog_reactions";
+DROP TYPE IF EXISTS "reaction_kind";
@@ -0,0 +1,29 @@
+CREATE TYPE "reaction_kind" AS ENUM (
+    'like',
+    'love',
+    'insightful',
+    'funny'
+    );
+
+-- A user has at most one reaction on a blog, reacting again replaces it.
+CREATE TABLE "blog_reactions" (
+                                  "blog_id" uuid NOT NULL REFERENCES "blog" ("id") ON DELETE CASCADE,
+                                  "user_id" uuid NOT NULL REFERENCES "users" ("id") ON DELETE CASCADE,
+                                  "kind" reaction_kind NOT NULL,
+                                  "created_at" timestamptz NOT NULL DEFAULT (now()),
+                                  PRIMARY KEY ("blog_id", "user_id")
+);
+
+CREATE INDEX ON "blog_reactions" ("created_at");
+
+-- The views of a blog are spread over hourly buckets and shards rather than kept on the blog row, every instance
+-- flushes its buffered views into a random shard so that concurrent flushes rarely wait on the same row.
+CREATE TABLE "blog_view_counters" (
+                                      "blog_id" uuid NOT NULL REFERENCES "blog" ("id") ON DELETE CASCADE,
+                                      "bucket" timestamptz NOT NULL,
+                                      "shard" smallint NOT NULL,
+                                      "views" bigint NOT NULL DEFAULT 0,
+                                      PRIMARY KEY ("blog_id", "bucket", "shard")
+);
+
+CREATE INDEX ON "blog_view_counters" ("bucket");
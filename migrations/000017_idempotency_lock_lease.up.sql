@@ -0,0 +1,3 @@
+-- The lease of a request still being processed, once it runs out the key is claimed again by a retry of the same
+-- request so that a crashed process does not hold the key until it expires.
+ALTER TABLE "idempotency_keys" ADD COLUMN "locked_until" timestamptz;
@@ -0,0 +1,189 @@
+// Package health runs the dependency checks behind the liveness, readiness and startup probes.
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	_defaultCacheTTL = 2 * time.Second
+	_defaultTimeout  = time.Second
+)
+
+// Probe -.
+type Probe string
+
+const (
+	// Liveness fails when the process should be restarted -.
+	Liveness Probe = "livez"
+	// Readiness fails when the process should not be sent traffic -.
+	Readiness Probe = "readyz"
+	// Startup fails until the process is done starting -.
+	Startup Probe = "startupz"
+)
+
+// Status -.
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusFail Status = "fail"
+)
+
+// CheckFunc returns an error when the dependency is unhealthy, it should return once the context is done -.
+type CheckFunc func(ctx context.Context) error
+
+// Result is the last run of a check -.
+type Result struct {
+	Status    Status    `json:"status"`
+	LatencyMS float64   `json:"latency_ms"`
+	CheckedAt time.Time `json:"checked_at"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Report is the outcome of a probe, it only passes when every check passes -.
+type Report struct {
+	Status Status            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// check caches its result so that the probes of many replicas or a busy kubelet can not stampede the dependency.
+// The lock also makes concurrent probes wait for the run in flight rather than start their own -.
+type check struct {
+	name   string
+	fn     CheckFunc
+	mu     sync.Mutex
+	result Result
+}
+
+// Registry -.
+type Registry struct {
+	mu       sync.RWMutex
+	probes   map[Probe][]*check
+	cacheTTL time.Duration
+	timeout  time.Duration
+	now      func() time.Time
+}
+
+// New -.
+func New(opts ...Option) *Registry {
+	r := &Registry{
+		probes:   make(map[Probe][]*check),
+		cacheTTL: _defaultCacheTTL,
+		timeout:  _defaultTimeout,
+		now:      time.Now,
+	}
+
+	// Custom options -.
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Register adds the check to the probes, a check shared by several probes is run once for all of them -.
+func (r *Registry) Register(name string, fn CheckFunc, probes ...Probe) {
+	c := &check{name: name, fn: fn}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, probe := range probes {
+		r.probes[probe] = append(r.probes[probe], c)
+	}
+}
+
+// Run runs the checks of the probe concurrently, a probe without checks passes -.
+func (r *Registry) Run(ctx context.Context, probe Probe) Report {
+	r.mu.RLock()
+	checks := r.probes[probe]
+	r.mu.RUnlock()
+
+	results := make([]Result, len(checks))
+
+	var wg sync.WaitGroup
+
+	for i, c := range checks {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			results[i] = r.run(ctx, c)
+		}()
+	}
+
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(checks))}
+
+	for i, c := range checks {
+		report.Checks[c.name] = results[i]
+
+		if results[i].Status != StatusOK {
+			report.Status = StatusFail
+		}
+	}
+
+	return report
+}
+
+// run returns the cached result of the check while it is fresh and runs it again otherwise -.
+func (r *Registry) run(ctx context.Context, c *check) Result {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.result.CheckedAt.IsZero() && r.now().Sub(c.result.CheckedAt) < r.cacheTTL {
+		return c.result
+	}
+
+	// The result is shared by every caller hence one caller going away must not fail it for the rest -.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.timeout)
+	defer cancel()
+
+	began := r.now()
+	err := c.fn(ctx)
+
+	c.result = Result{
+		Status:    StatusOK,
+		LatencyMS: float64(r.now().Sub(began).Microseconds()) / 1000,
+		CheckedAt: began,
+	}
+
+	if err != nil {
+		c.result.Status = StatusFail
+		c.result.Error = err.Error()
+	}
+
+	return c.result
+}
+
+// HTTPCheck fails unless a GET of the url answers with a 2xx status, it checks the downstream services -.
+func HTTPCheck(client *http.Client, url string) CheckFunc {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+
+		if err != nil {
+			return err
+		}
+
+		res, err := client.Do(req)
+
+		if err != nil {
+			return err
+		}
+
+		defer res.Body.Close()
+
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			return fmt.Errorf("health - HTTPCheck: %s answered %s", url, res.Status)
+		}
+
+		return nil
+	}
+}
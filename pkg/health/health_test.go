@@ -0,0 +1,96 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("probes only run their checks", func(t *testing.T) {
+		r := New()
+		r.Register("postgres", func(context.Context) error { return errors.New("connection refused") }, Readiness, Startup)
+
+		assert.Equal(t, StatusOK, r.Run(context.Background(), Liveness).Status)
+
+		report := r.Run(context.Background(), Readiness)
+
+		assert.Equal(t, StatusFail, report.Status)
+		assert.Equal(t, "connection refused", report.Checks["postgres"].Error)
+	})
+
+	t.Run("cached results spare the dependency", func(t *testing.T) {
+		var runs atomic.Int32
+		r := New(CacheTTL(time.Minute))
+		r.Register("postgres", func(context.Context) error {
+			runs.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		}, Readiness, Startup)
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				assert.Equal(t, StatusOK, r.Run(context.Background(), Readiness).Status)
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Equal(t, StatusOK, r.Run(context.Background(), Startup).Status)
+		assert.Equal(t, int32(1), runs.Load())
+	})
+
+	t.Run("stale results are checked again", func(t *testing.T) {
+		var runs atomic.Int32
+		now := time.Now()
+		r := New(CacheTTL(time.Second))
+		r.now = func() time.Time { return now }
+		r.Register("postgres", func(context.Context) error {
+			runs.Add(1)
+			return nil
+		}, Readiness)
+
+		r.Run(context.Background(), Readiness)
+		now = now.Add(time.Second)
+		r.Run(context.Background(), Readiness)
+
+		assert.Equal(t, int32(2), runs.Load())
+	})
+
+	t.Run("slow checks time out", func(t *testing.T) {
+		r := New(Timeout(10 * time.Millisecond))
+		r.Register("postgres", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, Readiness)
+
+		report := r.Run(context.Background(), Readiness)
+
+		assert.Equal(t, StatusFail, report.Status)
+		assert.GreaterOrEqual(t, report.Checks["postgres"].LatencyMS, float64(10))
+	})
+}
+
+func TestHTTPCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	assert.NoError(t, HTTPCheck(server.Client(), server.URL+"/healthz")(context.Background()))
+	assert.ErrorContains(t, HTTPCheck(server.Client(), server.URL+"/down")(context.Background()), "503 Service Unavailable")
+}
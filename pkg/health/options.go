@@ -0,0 +1,20 @@
+package health
+
+import "time"
+
+// Option -.
+type Option func(*Registry)
+
+// CacheTTL is how long the result of a check is reused, however often the probes are called -.
+func CacheTTL(ttl time.Duration) Option {
+	return func(r *Registry) {
+		r.cacheTTL = ttl
+	}
+}
+
+// Timeout bounds every run of a check -.
+func Timeout(timeout time.Duration) Option {
+	return func(r *Registry) {
+		r.timeout = timeout
+	}
+}
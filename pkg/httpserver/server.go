@@ -3,6 +3,7 @@ package httpserver
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"time"
 )
@@ -21,7 +22,7 @@ type Server struct {
 	shutdownTimeout time.Duration
 }
 
-// New -.
+// New the server only listens once it is started -.
 func New(handler http.Handler, opts ...Option) *Server {
 	httpServer := &http.Server{
 		Handler:      handler,
@@ -41,16 +42,24 @@ func New(handler http.Handler, opts ...Option) *Server {
 		opt(s)
 	}
 
-	s.start()
-
 	return s
 }
 
-func (s *Server) start() {
+// Start listens on the address right away so that a port already in use fails the start, the requests are then
+// served in the background until Shutdown -.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.server.Addr)
+
+	if err != nil {
+		return err
+	}
+
 	go func() {
-		s.notify <- s.server.ListenAndServe()
+		s.notify <- s.server.Serve(listener)
 		close(s.notify)
 	}()
+
+	return nil
 }
 
 // Notify -.
@@ -58,9 +67,9 @@ func (s *Server) Notify() <-chan error {
 	return s.notify
 }
 
-// Shutdown -.
-func (s *Server) Shutdown() error {
-	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+// Shutdown stops taking new connections and waits for the requests in flight until the timeout or the context ends -.
+func (s *Server) Shutdown(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, s.shutdownTimeout)
 	defer cancel()
 
 	return s.server.Shutdown(ctx)
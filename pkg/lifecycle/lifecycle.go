@@ -0,0 +1,142 @@
+// Package lifecycle starts the components of the app in order and stops them in reverse.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	_defaultStartTimeout = 15 * time.Second
+	_defaultGracePeriod  = 30 * time.Second
+	_defaultDrainDelay   = 0
+)
+
+// Hook starts and stops a component, either may be nil. Stop is only called when Start succeeded -.
+type Hook struct {
+	Name  string
+	Start func(ctx context.Context) error
+	Stop  func(ctx context.Context) error
+}
+
+// Manager -.
+type Manager struct {
+	l            logger.Interface
+	hooks        []Hook
+	started      int
+	ready        atomic.Bool
+	stopOnce     sync.Once
+	startTimeout time.Duration
+	gracePeriod  time.Duration
+	drainDelay   time.Duration
+}
+
+// New -.
+func New(l logger.Interface, opts ...Option) *Manager {
+	m := &Manager{
+		l:            l,
+		startTimeout: _defaultStartTimeout,
+		gracePeriod:  _defaultGracePeriod,
+		drainDelay:   _defaultDrainDelay,
+	}
+
+	// Custom options -.
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Append registers the hooks, they start in the order they are appended -.
+func (m *Manager) Append(hooks ...Hook) {
+	m.hooks = append(m.hooks, hooks...)
+}
+
+// Ready reports whether every component started and the stop has not begun -.
+func (m *Manager) Ready() bool {
+	return m.ready.Load()
+}
+
+// Start runs the start hooks in order. When one fails the components already started are stopped again and the
+// error of the failed hook is returned -.
+func (m *Manager) Start(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, m.startTimeout)
+	defer cancel()
+
+	for _, hook := range m.hooks {
+		began := time.Now()
+
+		if hook.Start != nil {
+			if err := hook.Start(ctx); err != nil {
+				err = fmt.Errorf("lifecycle - Start - %s: %w", hook.Name, err)
+
+				if stopErr := m.stop(); stopErr != nil {
+					m.l.Error(stopErr)
+				}
+
+				return err
+			}
+		}
+
+		m.started++
+		m.l.Info("lifecycle - Start - %s started in %s", hook.Name, time.Since(began))
+	}
+
+	m.ready.Store(true)
+
+	return nil
+}
+
+// Stop fails the readiness, waits out the drain delay so that no new traffic is routed to the app and then runs
+// the stop hooks of the started components in reverse order within the grace period. It only stops them once -.
+func (m *Manager) Stop() error {
+	var err error
+
+	m.stopOnce.Do(func() {
+		m.ready.Store(false)
+
+		if m.started > 0 && m.drainDelay > 0 {
+			m.l.Info("lifecycle - Stop - draining for %s", m.drainDelay)
+			time.Sleep(m.drainDelay)
+		}
+
+		err = m.stop()
+	})
+
+	return err
+}
+
+// stop runs the stop hooks of the started components in reverse order, a failed hook does not keep the ones
+// started before it from stopping -.
+func (m *Manager) stop() error {
+	m.ready.Store(false)
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.gracePeriod)
+	defer cancel()
+
+	var errs []error
+
+	for ; m.started > 0; m.started-- {
+		hook := m.hooks[m.started-1]
+		began := time.Now()
+
+		if hook.Stop == nil {
+			continue
+		}
+
+		if err := hook.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("lifecycle - Stop - %s: %w", hook.Name, err))
+			continue
+		}
+
+		m.l.Info("lifecycle - Stop - %s stopped in %s", hook.Name, time.Since(began))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,78 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"github.com/harmannkibue/golang_gin_clean_architecture/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// recordedHook appends its start and stop to the calls -.
+func recordedHook(name string, calls *[]string, startErr error) Hook {
+	return Hook{
+		Name: name,
+		Start: func(context.Context) error {
+			*calls = append(*calls, "start "+name)
+			return startErr
+		},
+		Stop: func(context.Context) error {
+			*calls = append(*calls, "stop "+name)
+			return nil
+		},
+	}
+}
+
+func TestManager(t *testing.T) {
+	t.Run("stops in reverse order", func(t *testing.T) {
+		var calls []string
+		m := New(logger.New("error"))
+		m.Append(recordedHook("postgres", &calls, nil), recordedHook("workers", &calls, nil), recordedHook("http", &calls, nil))
+
+		assert.False(t, m.Ready())
+		assert.NoError(t, m.Start(context.Background()))
+		assert.True(t, m.Ready())
+		assert.NoError(t, m.Stop())
+		assert.False(t, m.Ready())
+		assert.NoError(t, m.Stop())
+
+		assert.Equal(t, []string{"start postgres", "start workers", "start http", "stop http", "stop workers", "stop postgres"}, calls)
+	})
+
+	t.Run("failed start stops what started", func(t *testing.T) {
+		var calls []string
+		unreachable := errors.New("connection refused")
+		m := New(logger.New("error"))
+		m.Append(recordedHook("postgres", &calls, nil), recordedHook("migrations", &calls, unreachable), recordedHook("http", &calls, nil))
+
+		err := m.Start(context.Background())
+
+		assert.ErrorIs(t, err, unreachable)
+		assert.ErrorContains(t, err, "migrations")
+		assert.False(t, m.Ready())
+		assert.Equal(t, []string{"start postgres", "start migrations", "stop postgres"}, calls)
+	})
+
+	t.Run("readiness fails while draining", func(t *testing.T) {
+		m := New(logger.New("error"), DrainDelay(20*time.Millisecond), GracePeriod(time.Second))
+		readyWhileStopping := true
+		m.Append(Hook{
+			Name: "http",
+			Stop: func(ctx context.Context) error {
+				readyWhileStopping = m.Ready()
+				_, hasDeadline := ctx.Deadline()
+				assert.True(t, hasDeadline)
+				return nil
+			},
+		})
+
+		assert.NoError(t, m.Start(context.Background()))
+
+		began := time.Now()
+
+		assert.NoError(t, m.Stop())
+		assert.False(t, readyWhileStopping)
+		assert.GreaterOrEqual(t, time.Since(began), 20*time.Millisecond)
+	})
+}
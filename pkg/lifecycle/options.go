@@ -0,0 +1,27 @@
+package lifecycle
+
+import "time"
+
+// Option -.
+type Option func(*Manager)
+
+// StartTimeout bounds the start of all the components -.
+func StartTimeout(timeout time.Duration) Option {
+	return func(m *Manager) {
+		m.startTimeout = timeout
+	}
+}
+
+// GracePeriod bounds the stop of all the components -.
+func GracePeriod(period time.Duration) Option {
+	return func(m *Manager) {
+		m.gracePeriod = period
+	}
+}
+
+// DrainDelay is how long the readiness fails before the components are stopped -.
+func DrainDelay(delay time.Duration) Option {
+	return func(m *Manager) {
+		m.drainDelay = delay
+	}
+}
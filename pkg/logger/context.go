@@ -0,0 +1,26 @@
+package logger
+
+import "context"
+
+// The fields bound to the logger of a request -.
+const (
+	RequestIDKey = "request_id"
+	UserIDKey    = "user_id"
+	TraceIDKey   = "trace_id"
+)
+
+type loggerKey struct{}
+
+// WithContext binds the logger to the context, the code handling the request then logs with its fields -.
+func WithContext(ctx context.Context, l Interface) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext returns the logger bound to the context, or the fallback when none is -.
+func FromContext(ctx context.Context, fallback Interface) Interface {
+	if l, ok := ctx.Value(loggerKey{}).(Interface); ok && l != nil {
+		return l
+	}
+
+	return fallback
+}
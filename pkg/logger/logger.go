@@ -5,6 +5,7 @@ import (
 	"github.com/rs/zerolog"
 	"os"
 	"strings"
+	"time"
 )
 
 // Interface -.
@@ -14,6 +15,8 @@ type Interface interface {
 	Warn(message string, args ...interface{})
 	Error(message interface{}, args ...interface{})
 	Fatal(message interface{}, args ...interface{})
+	// With returns a logger adding the field to all of its entries, the receiver is left as it is -.
+	With(key string, value interface{}) Interface
 }
 
 // Logger -.
@@ -24,7 +27,14 @@ type Logger struct {
 var _ Interface = (*Logger)(nil)
 
 // New -.
-func New(level string) *Logger {
+func New(level string, opts ...Option) *Logger {
+	o := &options{format: "json", output: os.Stdout}
+
+	// Custom options -.
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	var l zerolog.Level
 
 	switch strings.ToLower(level) {
@@ -40,10 +50,22 @@ func New(level string) *Logger {
 		l = zerolog.InfoLevel
 	}
 
-	zerolog.SetGlobalLevel(l)
+	output := o.output
+
+	if strings.EqualFold(o.format, "console") {
+		output = zerolog.ConsoleWriter{Out: o.output, TimeFormat: time.RFC3339}
+	}
+
+	// The entries are written by Logger.write hence its caller and the caller of the level method are skipped -.
+	skipFrameCount := 2
+	logger := zerolog.New(output).Level(l).With().Timestamp().CallerWithSkipFrameCount(zerolog.CallerSkipFrameCount + skipFrameCount).Logger()
 
-	skipFrameCount := 3
-	logger := zerolog.New(os.Stdout).With().Timestamp().CallerWithSkipFrameCount(zerolog.CallerSkipFrameCount + skipFrameCount).Logger()
+	if o.sampleDebug > 1 || o.sampleInfo > 1 {
+		logger = logger.Sample(zerolog.LevelSampler{
+			DebugSampler: sampler(o.sampleDebug),
+			InfoSampler:  sampler(o.sampleInfo),
+		})
+	}
 
 	return &Logger{
 		logger: &logger,
@@ -52,50 +74,68 @@ func New(level string) *Logger {
 
 // Debug -.
 func (l *Logger) Debug(message interface{}, args ...interface{}) {
-	l.msg("debug", message, args...)
+	l.write(zerolog.DebugLevel, message, args...)
 }
 
 // Info -.
 func (l *Logger) Info(message string, args ...interface{}) {
-	l.log(message, args...)
+	l.write(zerolog.InfoLevel, message, args...)
 }
 
 // Warn -.
 func (l *Logger) Warn(message string, args ...interface{}) {
-	l.log(message, args...)
+	l.write(zerolog.WarnLevel, message, args...)
 }
 
 // Error -.
 func (l *Logger) Error(message interface{}, args ...interface{}) {
-	if l.logger.GetLevel() == zerolog.DebugLevel {
-		l.Debug(message, args...)
-	}
-
-	l.msg("error", message, args...)
+	l.write(zerolog.ErrorLevel, message, args...)
 }
 
 // Fatal -.
 func (l *Logger) Fatal(message interface{}, args ...interface{}) {
-	l.msg("fatal", message, args...)
+	l.write(zerolog.FatalLevel, message, args...)
 
 	os.Exit(1)
 }
 
-func (l *Logger) log(message string, args ...interface{}) {
-	if len(args) == 0 {
-		l.logger.Info().Msg(message)
-	} else {
-		l.logger.Info().Msgf(message, args...)
+// With -.
+func (l *Logger) With(key string, value interface{}) Interface {
+	logger := l.logger.With().Interface(key, value).Logger()
+
+	return &Logger{
+		logger: &logger,
 	}
 }
 
-func (l *Logger) msg(level string, message interface{}, args ...interface{}) {
-	switch msg := message.(type) {
+// write logs the message at the level, the args format the message. Fatal entries are written through WithLevel
+// as zerolog would otherwise exit before Logger.Fatal does -.
+func (l *Logger) write(level zerolog.Level, message interface{}, args ...interface{}) {
+	event := l.logger.WithLevel(level)
+
+	var msg string
+
+	switch m := message.(type) {
 	case error:
-		l.log(msg.Error(), args...)
+		msg = m.Error()
 	case string:
-		l.log(msg, args...)
+		msg = m
 	default:
-		l.log(fmt.Sprintf("%s message %v has unknown type %v", level, message, msg), args...)
+		msg = fmt.Sprintf("%s message %v has unknown type %T", level, message, message)
+	}
+
+	if len(args) == 0 {
+		event.Msg(msg)
+	} else {
+		event.Msgf(msg, args...)
+	}
+}
+
+// sampler keeps one in every n entries, nil keeps them all -.
+func sampler(n uint32) zerolog.Sampler {
+	if n <= 1 {
+		return nil
 	}
+
+	return &zerolog.BasicSampler{N: n}
 }
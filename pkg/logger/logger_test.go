@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// entries decodes the json lines written to the buffer -.
+func entries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var decoded []map[string]interface{}
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var entry map[string]interface{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+		decoded = append(decoded, entry)
+	}
+
+	return decoded
+}
+
+func TestLogger(t *testing.T) {
+	t.Run("levels", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := New("info", Output(&buf))
+
+		l.Debug("hidden")
+		l.Info("started %d workers", 4)
+		l.Warn("slow")
+		l.Error(errors.New("failed"))
+
+		logged := entries(t, &buf)
+
+		assert.Len(t, logged, 3)
+		assert.Equal(t, []interface{}{"info", "started 4 workers"}, []interface{}{logged[0]["level"], logged[0]["message"]})
+		assert.Equal(t, "warn", logged[1]["level"])
+		assert.Equal(t, []interface{}{"error", "failed"}, []interface{}{logged[2]["level"], logged[2]["message"]})
+		assert.Contains(t, logged[0]["caller"], "logger_test.go")
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := New("info", Output(&buf))
+		requestLogger := l.With(RequestIDKey, "abc").With(UserIDKey, "42")
+
+		ctx := WithContext(context.Background(), requestLogger)
+		FromContext(ctx, l).Info("handled")
+		FromContext(context.Background(), l).Info("unbound")
+
+		logged := entries(t, &buf)
+
+		assert.Equal(t, "abc", logged[0][RequestIDKey])
+		assert.Equal(t, "42", logged[0][UserIDKey])
+		assert.NotContains(t, logged[1], RequestIDKey)
+	})
+
+	t.Run("sampling", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := New("debug", Output(&buf), SampleDebug(5))
+
+		for i := 0; i < 10; i++ {
+			l.Debug("noisy")
+		}
+
+		l.Warn("kept")
+
+		assert.Len(t, entries(t, &buf), 3)
+	})
+
+	t.Run("console", func(t *testing.T) {
+		var buf bytes.Buffer
+		New("info", Output(&buf), Format("console")).With(RequestIDKey, "abc").Info("handled")
+
+		assert.Contains(t, buf.String(), "INF")
+		assert.Contains(t, buf.String(), "handled")
+		assert.NotContains(t, buf.String(), `"message"`)
+	})
+}
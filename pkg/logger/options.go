@@ -0,0 +1,41 @@
+package logger
+
+import "io"
+
+// Option -.
+type Option func(*options)
+
+type options struct {
+	format      string
+	output      io.Writer
+	sampleDebug uint32
+	sampleInfo  uint32
+}
+
+// Format is json for the log collectors or console for people reading the logs, json is the default -.
+func Format(format string) Option {
+	return func(o *options) {
+		o.format = format
+	}
+}
+
+// Output -.
+func Output(w io.Writer) Option {
+	return func(o *options) {
+		o.output = w
+	}
+}
+
+// SampleDebug keeps one in every n debug entries, 0 and 1 keep them all -.
+func SampleDebug(n uint32) Option {
+	return func(o *options) {
+		o.sampleDebug = n
+	}
+}
+
+// SampleInfo keeps one in every n info entries, 0 and 1 keep them all -.
+func SampleInfo(n uint32) Option {
+	return func(o *options) {
+		o.sampleInfo = n
+	}
+}
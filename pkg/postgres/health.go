@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+)
+
+// _upMigration matches the file names of golang-migrate e.g 000016_blog_activity.up.sql -.
+var _upMigration = regexp.MustCompile(`^(\d+)_.+\.up\.sql$`)
+
+// PoolCheck fails when the share of the open connections in use reaches the saturation, the requests would
+// otherwise queue for a connection -.
+func PoolCheck(pool *sql.DB, saturation float64) func(ctx context.Context) error {
+	return func(context.Context) error {
+		stats := pool.Stats()
+
+		if stats.MaxOpenConnections == 0 {
+			return nil
+		}
+
+		if inUse := float64(stats.InUse) / float64(stats.MaxOpenConnections); inUse >= saturation {
+			return fmt.Errorf("postgres - PoolCheck: %d of %d connections in use, %d requests waited for one",
+				stats.InUse, stats.MaxOpenConnections, stats.WaitCount)
+		}
+
+		return nil
+	}
+}
+
+// MigrationsCheck fails until the database is migrated to the latest migration in dir, or when a migration
+// failed half way -.
+func MigrationsCheck(pool *sql.DB, dir string) (func(ctx context.Context) error, error) {
+	latest, err := latestMigration(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) error {
+		var version int64
+		var dirty bool
+
+		err := pool.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty)
+
+		if err != nil {
+			return fmt.Errorf("postgres - MigrationsCheck: %w", err)
+		}
+
+		if dirty {
+			return fmt.Errorf("postgres - MigrationsCheck: migration %d is dirty", version)
+		}
+
+		if version < latest {
+			return fmt.Errorf("postgres - MigrationsCheck: migrated to %d of %d", version, latest)
+		}
+
+		return nil
+	}, nil
+}
+
+// latestMigration is the highest version of the migrations in dir -.
+func latestMigration(dir string) (int64, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return 0, fmt.Errorf("postgres - latestMigration: %w", err)
+	}
+
+	var latest int64
+
+	for _, entry := range entries {
+		match := _upMigration.FindStringSubmatch(entry.Name())
+
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+
+		if err != nil {
+			return 0, fmt.Errorf("postgres - latestMigration: %w", err)
+		}
+
+		latest = max(latest, version)
+	}
+
+	return latest, nil
+}
@@ -3,8 +3,9 @@ package postgres
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/harmannkibue/golang_gin_clean_architecture/config"
-	"log"
 )
 
 const (
@@ -13,12 +14,12 @@ const (
 	_maximumOpenConnections = 50
 )
 
-// New -.
+// New opens the pool without connecting, the app pings it on start -.
 func New(cfg *config.Config) (*sql.DB, error) {
 	dbSource := cfg.PG.PostgresUrl
 
 	if len(dbSource) == 0 {
-		log.Fatal("POSTGRES URL CONFIGS NOT PASSED ")
+		return nil, errors.New("postgres - New: the PG_URL config is not set")
 	}
 
 	// Opening a driver typically will not attempt to connect to the database.
@@ -27,12 +28,9 @@ func New(cfg *config.Config) (*sql.DB, error) {
 	if err != nil {
 		// This will not be a connection error, but a dbSource parse error or
 		// another initialization error.
-		log.Fatal("unable to use data source name", err)
+		return nil, fmt.Errorf("postgres - New - sql.Open: %w", err)
 	}
 
-	// Should we have the db closed after implementation -.
-	//defer pool.Close()
-
 	pool.SetConnMaxLifetime(_maxLifeTime)
 	pool.SetMaxIdleConns(_maxIdleConnections)
 	pool.SetMaxOpenConns(_maximumOpenConnections)
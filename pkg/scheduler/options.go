@@ -0,0 +1,29 @@
+package scheduler
+
+import (
+	"time"
+)
+
+// Option -.
+type Option func(*Scheduler)
+
+// Interval -.
+func Interval(interval time.Duration) Option {
+	return func(s *Scheduler) {
+		s.interval = interval
+	}
+}
+
+// ShutdownTimeout -.
+func ShutdownTimeout(timeout time.Duration) Option {
+	return func(s *Scheduler) {
+		s.shutdownTimeout = timeout
+	}
+}
+
+// OnError is called with the error of every failed run -.
+func OnError(fn func(error)) Option {
+	return func(s *Scheduler) {
+		s.onError = fn
+	}
+}
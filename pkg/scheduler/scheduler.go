@@ -0,0 +1,86 @@
+// Package scheduler implements a background job runner.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	_defaultInterval        = time.Minute
+	_defaultShutdownTimeout = 3 * time.Second
+)
+
+// ErrShutdownTimeout is returned by Shutdown when the running job does not stop in time -.
+var ErrShutdownTimeout = errors.New("scheduler: job did not stop before the shutdown timeout")
+
+// Job is run on every tick, the context is cancelled on Shutdown -.
+type Job func(ctx context.Context) error
+
+// Scheduler -.
+type Scheduler struct {
+	job             Job
+	interval        time.Duration
+	shutdownTimeout time.Duration
+	onError         func(error)
+	cancel          context.CancelFunc
+	done            chan struct{}
+}
+
+// New starts running the job every interval, the first run is right away -.
+func New(job Job, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		job:             job,
+		interval:        _defaultInterval,
+		shutdownTimeout: _defaultShutdownTimeout,
+		onError:         func(error) {},
+		done:            make(chan struct{}),
+	}
+
+	// Custom options -.
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.start()
+
+	return s
+}
+
+func (s *Scheduler) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			// A failed run is retried on the next tick -.
+			if err := s.job(ctx); err != nil && ctx.Err() == nil {
+				s.onError(err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Shutdown cancels the running job and waits for it to return -.
+func (s *Scheduler) Shutdown() error {
+	s.cancel()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-time.After(s.shutdownTimeout):
+		return ErrShutdownTimeout
+	}
+}
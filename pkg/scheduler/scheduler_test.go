@@ -0,0 +1,80 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("runs right away and on every tick", func(t *testing.T) {
+		var runs atomic.Int32
+
+		s := New(func(context.Context) error {
+			runs.Add(1)
+			return nil
+		}, Interval(10*time.Millisecond))
+
+		assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, time.Millisecond)
+		assert.NoError(t, s.Shutdown())
+
+		stopped := runs.Load()
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, stopped, runs.Load())
+	})
+
+	t.Run("failed runs are reported and retried on the next tick", func(t *testing.T) {
+		errJob := errors.New("job failed")
+		errs := make(chan error, 1)
+		var runs atomic.Int32
+
+		s := New(func(context.Context) error {
+			if runs.Add(1) == 1 {
+				return errJob
+			}
+
+			return nil
+		}, Interval(10*time.Millisecond), OnError(func(err error) { errs <- err }))
+
+		assert.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, time.Millisecond)
+		assert.NoError(t, s.Shutdown())
+		assert.Equal(t, errJob, <-errs)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("shutdown cancels the running job", func(t *testing.T) {
+		started := make(chan struct{})
+		var reported atomic.Bool
+
+		s := New(func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+
+			return ctx.Err()
+		}, OnError(func(error) { reported.Store(true) }))
+
+		<-started
+		assert.NoError(t, s.Shutdown())
+		// The error of a cancelled run is the shutdown rather than a failure -.
+		assert.False(t, reported.Load())
+	})
+
+	t.Run("jobs ignoring the cancellation time out", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+
+		s := New(func(context.Context) error {
+			close(started)
+			<-release
+
+			return nil
+		}, ShutdownTimeout(20*time.Millisecond))
+
+		<-started
+		assert.ErrorIs(t, s.Shutdown(), ErrShutdownTimeout)
+	})
+}
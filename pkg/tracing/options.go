@@ -0,0 +1,58 @@
+package tracing
+
+import sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+// Option -.
+type Option func(*options)
+
+type options struct {
+	serviceName    string
+	serviceVersion string
+	exporter       string
+	endpoint       string
+	sampleRatio    float64
+	spanExporter   sdktrace.SpanExporter
+}
+
+// ServiceName -.
+func ServiceName(name string) Option {
+	return func(o *options) {
+		o.serviceName = name
+	}
+}
+
+// ServiceVersion -.
+func ServiceVersion(version string) Option {
+	return func(o *options) {
+		o.serviceVersion = version
+	}
+}
+
+// Exporter is none, stdout or otlp, none is the default -.
+func Exporter(exporter string) Option {
+	return func(o *options) {
+		o.exporter = exporter
+	}
+}
+
+// Endpoint is the OTLP/HTTP url of the collector such as http://otel-collector:4318 -.
+func Endpoint(endpoint string) Option {
+	return func(o *options) {
+		o.endpoint = endpoint
+	}
+}
+
+// SampleRatio is the share of the new traces that are recorded, from 0 to 1 -.
+func SampleRatio(ratio float64) Option {
+	return func(o *options) {
+		o.sampleRatio = ratio
+	}
+}
+
+// SpanExporter exports the spans to the exporter rather than the one named by Exporter, the tests pass an in-memory
+// one -.
+func SpanExporter(exporter sdktrace.SpanExporter) Option {
+	return func(o *options) {
+		o.spanExporter = exporter
+	}
+}
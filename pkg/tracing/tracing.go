@@ -0,0 +1,101 @@
+// Package tracing sets up OpenTelemetry tracing with the W3C trace context propagated in and out of the service.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName names the tracers of the app so that its spans can be told from those of the libraries -.
+const InstrumentationName = "github.com/harmannkibue/golang_gin_clean_architecture"
+
+const (
+	_defaultServiceName = "blog-api"
+	_defaultSampleRatio = 1
+)
+
+// Exporters -.
+const (
+	// ExporterNone records the spans without exporting them, the trace context is still propagated -.
+	ExporterNone = "none"
+	// ExporterStdout prints the spans for local runs -.
+	ExporterStdout = "stdout"
+	// ExporterOTLP sends the spans to a collector over OTLP/HTTP -.
+	ExporterOTLP = "otlp"
+)
+
+// New builds the tracer provider and installs it with the W3C trace context and baggage propagators as the global
+// ones, the instrumentation of the app reads them from the otel package. Shut the provider down to flush the spans -.
+func New(opts ...Option) (*sdktrace.TracerProvider, error) {
+	o := &options{
+		serviceName: _defaultServiceName,
+		exporter:    ExporterNone,
+		sampleRatio: _defaultSampleRatio,
+	}
+
+	// Custom options -.
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	// The callers decide for the traces they propagate so that a trace is either recorded whole or not at all -.
+	providerOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(o.sampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(o.serviceName),
+			semconv.ServiceVersion(o.serviceVersion),
+		)),
+	}
+
+	switch {
+	case o.spanExporter != nil:
+		// The spans are exported as they end so that the tests can read them right away -.
+		providerOpts = append(providerOpts, sdktrace.WithSyncer(o.spanExporter))
+	case o.exporter == ExporterNone:
+	case o.exporter == ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+
+		if err != nil {
+			return nil, fmt.Errorf("tracing - New - stdouttrace.New: %w", err)
+		}
+
+		providerOpts = append(providerOpts, sdktrace.WithSyncer(exporter))
+	case o.exporter == ExporterOTLP:
+		var exporterOpts []otlptracehttp.Option
+
+		// Without an endpoint the exporter reads OTEL_EXPORTER_OTLP_ENDPOINT and falls back to localhost:4318 -.
+		if o.endpoint != "" {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithEndpointURL(o.endpoint))
+		}
+
+		exporter, err := otlptracehttp.New(context.Background(), exporterOpts...)
+
+		if err != nil {
+			return nil, fmt.Errorf("tracing - New - otlptracehttp.New: %w", err)
+		}
+
+		providerOpts = append(providerOpts, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("tracing - New: unknown exporter %q, use %s, %s or %s", o.exporter, ExporterNone, ExporterStdout, ExporterOTLP)
+	}
+
+	provider := sdktrace.NewTracerProvider(providerOpts...)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider, nil
+}
+
+// Tracer returns the tracer of the app from the global provider -.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("ratio of 0 records no new traces", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		provider, err := New(SpanExporter(exporter), SampleRatio(0))
+		assert.NoError(t, err)
+
+		defer provider.Shutdown(context.Background())
+
+		_, span := Tracer().Start(context.Background(), "unsampled")
+		span.End()
+
+		assert.False(t, span.SpanContext().IsSampled())
+		assert.Empty(t, exporter.GetSpans())
+	})
+
+	t.Run("sampled callers are recorded whatever the ratio", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		provider, err := New(SpanExporter(exporter), SampleRatio(0))
+		assert.NoError(t, err)
+
+		defer provider.Shutdown(context.Background())
+
+		header := http.Header{"Traceparent": {"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}}
+		ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(header))
+
+		_, span := Tracer().Start(ctx, "sampled")
+		span.End()
+
+		spans := exporter.GetSpans()
+
+		if assert.Len(t, spans, 1) {
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext.TraceID().String())
+			assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent.SpanID().String())
+		}
+	})
+
+	t.Run("unknown exporter", func(t *testing.T) {
+		_, err := New(Exporter("jaeger"))
+
+		assert.ErrorContains(t, err, `unknown exporter "jaeger"`)
+	})
+}
+
+func TestTransport(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider, err := New(SpanExporter(exporter))
+	assert.NoError(t, err)
+
+	defer provider.Shutdown(context.Background())
+
+	var traceparent string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, parent := Tracer().Start(context.Background(), "check")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	res, err := (&http.Client{Transport: Transport(nil)}).Do(req)
+	assert.NoError(t, err)
+	_ = res.Body.Close()
+	parent.End()
+
+	spans := exporter.GetSpans()
+
+	if assert.Len(t, spans, 2) {
+		client := spans[0]
+
+		assert.Equal(t, trace.SpanKindClient, client.SpanKind)
+		assert.Equal(t, parent.SpanContext().SpanID(), client.Parent.SpanID())
+		// The server continues the trace under the client span -.
+		assert.Equal(t, "00-"+client.SpanContext.TraceID().String()+"-"+client.SpanContext.SpanID().String()+"-01", traceparent)
+		assert.Equal(t, "Error", client.Status.Code.String())
+	}
+}